@@ -0,0 +1,16 @@
+action "supabase_branch_push" "staging" {
+  config {
+    branch_id = "b2a9cc54-4e5b-4f4a-9c3b-2c1e8d6f0a11"
+  }
+}
+
+resource "terraform_data" "release" {
+  input = "v1"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.supabase_branch_push.staging]
+    }
+  }
+}
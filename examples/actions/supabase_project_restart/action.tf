@@ -0,0 +1,16 @@
+action "supabase_project_restart" "production" {
+  config {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
+
+resource "terraform_data" "maintenance" {
+  input = "2025-01-01"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.supabase_project_restart.production]
+    }
+  }
+}
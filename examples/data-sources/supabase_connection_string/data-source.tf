@@ -0,0 +1,5 @@
+data "supabase_connection_string" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  mode        = "transaction"
+  password    = "barbaz"
+}
@@ -0,0 +1,5 @@
+data "supabase_instance_sizes" "production" {
+  project_ref       = "mayuaycdtijbctgqbycg"
+  organization_slug = "continued-brown-smelt"
+  region            = "us-east-1"
+}
@@ -0,0 +1,3 @@
+data "supabase_jwks" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+}
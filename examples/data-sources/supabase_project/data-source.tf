@@ -0,0 +1,4 @@
+data "supabase_project" "production" {
+  organization_id = "continued-brown-smelt"
+  name            = "foo"
+}
@@ -0,0 +1,4 @@
+data "supabase_project_addon_variants" "pitr" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  addon_type  = "pitr"
+}
@@ -0,0 +1,5 @@
+data "supabase_projects" "staging" {
+  organization_id = "continued-brown-smelt"
+  status          = "ACTIVE_HEALTHY"
+  name_regex      = "^staging-"
+}
@@ -0,0 +1,4 @@
+data "supabase_regions" "all" {
+  organization_slug = "continued-brown-smelt"
+  instance_size     = "micro"
+}
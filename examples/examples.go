@@ -9,14 +9,42 @@ var (
 	ProjectResourceConfig string
 	//go:embed resources/supabase_branch/resource.tf
 	BranchResourceConfig string
+	//go:embed resources/supabase_branching/resource.tf
+	BranchingResourceConfig string
 	//go:embed resources/supabase_apikey/resource.tf
 	ApiKeyResourceConfig string
 	//go:embed resources/supabase_function/resource.tf
 	FunctionResourceConfig string
+	//go:embed resources/supabase_legacy_api_keys/resource.tf
+	LegacyApiKeysResourceConfig string
+	//go:embed resources/supabase_jwt_signing_key/resource.tf
+	JwtSigningKeyResourceConfig string
+	//go:embed resources/supabase_project_addon/resource.tf
+	ProjectAddonResourceConfig string
+	//go:embed resources/supabase_read_replica/resource.tf
+	ReadReplicaResourceConfig string
+	//go:embed actions/supabase_branch_merge/action.tf
+	BranchMergeActionConfig string
+	//go:embed actions/supabase_project_restart/action.tf
+	ProjectRestartActionConfig string
 	//go:embed data-sources/supabase_branch/data-source.tf
 	BranchDataSourceConfig string
 	//go:embed data-sources/supabase_pooler/data-source.tf
 	PoolerDataSourceConfig string
 	//go:embed data-sources/supabase_apikeys/data-source.tf
 	APIKeysDataSourceConfig string
+	//go:embed data-sources/supabase_jwks/data-source.tf
+	JwksDataSourceConfig string
+	//go:embed data-sources/supabase_connection_string/data-source.tf
+	ConnectionStringDataSourceConfig string
+	//go:embed data-sources/supabase_project/data-source.tf
+	ProjectDataSourceConfig string
+	//go:embed data-sources/supabase_projects/data-source.tf
+	ProjectsDataSourceConfig string
+	//go:embed data-sources/supabase_project_addon_variants/data-source.tf
+	ProjectAddonVariantsDataSourceConfig string
+	//go:embed data-sources/supabase_regions/data-source.tf
+	RegionsDataSourceConfig string
+	//go:embed data-sources/supabase_instance_sizes/data-source.tf
+	InstanceSizesDataSourceConfig string
 )
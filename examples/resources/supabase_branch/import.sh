@@ -1,2 +1,3 @@
-# The ID is the branch identifier.
+# The ID is either the branch identifier or the parent project reference and git branch.
 terraform import supabase_branch.development <branch_id>
+terraform import supabase_branch.development <parent_project_ref>/<git_branch>
@@ -0,0 +1,2 @@
+# The ID is the project reference.
+terraform import supabase_branching.production <project_ref>
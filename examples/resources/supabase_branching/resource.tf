@@ -0,0 +1,4 @@
+resource "supabase_branching" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  git_branch  = "main"
+}
@@ -0,0 +1,2 @@
+# The ID is the project reference and a unique identifier of the signing key separated by '/'
+terraform import supabase_jwt_signing_key.standby <project_ref>/<signing_key_id>
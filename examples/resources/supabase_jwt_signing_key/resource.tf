@@ -0,0 +1,5 @@
+resource "supabase_jwt_signing_key" "standby" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  algorithm   = "ES256"
+  status      = "standby"
+}
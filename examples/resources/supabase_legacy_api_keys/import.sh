@@ -0,0 +1,2 @@
+# The ID is the project reference.
+terraform import supabase_legacy_api_keys.production <project_ref>
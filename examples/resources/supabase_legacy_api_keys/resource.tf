@@ -0,0 +1,4 @@
+resource "supabase_legacy_api_keys" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  enabled     = false
+}
@@ -0,0 +1,2 @@
+# The ID is the project reference and add-on type separated by a slash.
+terraform import supabase_project_addon.pitr <project_ref>/pitr
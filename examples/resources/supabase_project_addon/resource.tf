@@ -0,0 +1,5 @@
+resource "supabase_project_addon" "pitr" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  addon_type  = "pitr"
+  variant     = "pitr_7"
+}
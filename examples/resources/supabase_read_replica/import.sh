@@ -0,0 +1,2 @@
+# The ID is the project reference and replica identifier separated by a slash.
+terraform import supabase_read_replica.west <project_ref>/<replica_identifier>
@@ -0,0 +1,4 @@
+resource "supabase_read_replica" "west" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  region      = "us-west-1"
+}
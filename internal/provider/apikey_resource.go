@@ -152,7 +152,7 @@ func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(createApiKey(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, createApiKey(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -174,7 +174,7 @@ func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	resp.Diagnostics.Append(readApiKey(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, readApiKey(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -192,7 +192,7 @@ func (r *APIKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(updateApiKey(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, updateApiKey(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
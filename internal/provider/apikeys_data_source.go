@@ -7,9 +7,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/supabase/cli/pkg/api"
@@ -30,10 +33,25 @@ type APIKeysDataSource struct {
 // APIKeysDataSourceModel describes the data source data model.
 type APIKeysDataSourceModel struct {
 	ProjectRef     types.String `tfsdk:"project_ref"`
+	Type           types.String `tfsdk:"type"`
+	Name           types.String `tfsdk:"name"`
 	AnonKey        types.String `tfsdk:"anon_key"`
 	ServiceRoleKey types.String `tfsdk:"service_role_key"`
 	PublishableKey types.String `tfsdk:"publishable_key"`
 	SecretKeys     types.List   `tfsdk:"secret_keys"`
+	Keys           types.List   `tfsdk:"keys"`
+}
+
+var apiKeyAttrTypes = map[string]attr.Type{
+	"id":                  types.StringType,
+	"name":                types.StringType,
+	"type":                types.StringType,
+	"description":         types.StringType,
+	"prefix":              types.StringType,
+	"api_key":             types.StringType,
+	"secret_jwt_template": types.ObjectType{AttrTypes: secretJwtTemplateAttrTypes},
+	"inserted_at":         types.StringType,
+	"updated_at":          types.StringType,
 }
 
 func (d *APIKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,6 +67,21 @@ func (d *APIKeysDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Project reference ID",
 				Required:            true,
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return keys of this type in `keys`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.ApiKeyResponseTypeLegacy),
+						string(api.ApiKeyResponseTypePublishable),
+						string(api.ApiKeyResponseTypeSecret),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return keys with this name in `keys`",
+				Optional:            true,
+			},
 			"anon_key": schema.StringAttribute{
 				MarkdownDescription: "Anonymous API key for the project",
 				Computed:            true,
@@ -60,7 +93,7 @@ func (d *APIKeysDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Sensitive:           true,
 			},
 			"publishable_key": schema.StringAttribute{
-				MarkdownDescription: "Publishable API key for the project",
+				MarkdownDescription: "Publishable API key for the project, preferring the key named `default`",
 				Computed:            true,
 				Sensitive:           true,
 			},
@@ -82,6 +115,57 @@ func (d *APIKeysDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 					},
 				},
 			},
+			"keys": schema.ListNestedAttribute{
+				MarkdownDescription: "List of API keys for the project matching the `type` and `name` filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "API key identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the API key",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the API key",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the API key",
+							Computed:            true,
+						},
+						"prefix": schema.StringAttribute{
+							MarkdownDescription: "Non-secret prefix of the API key",
+							Computed:            true,
+						},
+						"api_key": schema.StringAttribute{
+							MarkdownDescription: "API key",
+							Computed:            true,
+							Sensitive:           true,
+						},
+						"secret_jwt_template": schema.SingleNestedAttribute{
+							MarkdownDescription: "Secret JWT template",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"role": schema.StringAttribute{
+									MarkdownDescription: "Role of the secret JWT template",
+									Computed:            true,
+								},
+							},
+						},
+						"inserted_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last update timestamp",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -131,8 +215,18 @@ func (d *APIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		},
 	}
 	var secretKeyObjects []attr.Value
+	keyObjects := []attr.Value{}
 
 	for _, key := range *httpResp.JSON200 {
+		if matchesApiKeyFilter(data, key) {
+			obj, diags := apiKeyObject(key)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			keyObjects = append(keyObjects, obj)
+		}
+
 		if key.Type.IsSpecified() && !key.Type.IsNull() {
 			keyType := key.Type.MustGet()
 
@@ -145,7 +239,10 @@ func (d *APIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 					data.ServiceRoleKey = NullableToString(key.ApiKey)
 				}
 			case api.ApiKeyResponseTypePublishable:
-				data.PublishableKey = NullableToString(key.ApiKey)
+				// Prefer the default key when several publishable keys exist
+				if data.PublishableKey.IsNull() || key.Name == "default" {
+					data.PublishableKey = NullableToString(key.ApiKey)
+				}
 			case api.ApiKeyResponseTypeSecret:
 				obj, diags := types.ObjectValue(objectType.AttrTypes, map[string]attr.Value{
 					"name":    types.StringValue(key.Name),
@@ -168,8 +265,53 @@ func (d *APIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 	data.SecretKeys = secretKeysList
 
+	keysList, diags := types.ListValue(types.ObjectType{AttrTypes: apiKeyAttrTypes}, keyObjects)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	data.Keys = keysList
+
 	tflog.Trace(ctx, "read API keys")
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// matchesApiKeyFilter reports whether the key satisfies the optional type and name filters.
+func matchesApiKeyFilter(data APIKeysDataSourceModel, key api.ApiKeyResponse) bool {
+	if !data.Type.IsNull() && !NullableToString(key.Type).Equal(data.Type) {
+		return false
+	}
+	if !data.Name.IsNull() && key.Name != data.Name.ValueString() {
+		return false
+	}
+	return true
+}
+
+func apiKeyObject(key api.ApiKeyResponse) (types.Object, diag.Diagnostics) {
+	role := types.StringNull()
+	if key.SecretJwtTemplate.IsSpecified() && !key.SecretJwtTemplate.IsNull() {
+		if value, ok := key.SecretJwtTemplate.MustGet()["role"].(string); ok {
+			role = types.StringValue(value)
+		}
+	}
+	secretJwtTemplate, diags := types.ObjectValue(secretJwtTemplateAttrTypes, map[string]attr.Value{
+		"role": role,
+	})
+	if diags.HasError() {
+		return types.ObjectNull(apiKeyAttrTypes), diags
+	}
+
+	return types.ObjectValue(apiKeyAttrTypes, map[string]attr.Value{
+		"id":                  NullableToString(key.Id),
+		"name":                types.StringValue(key.Name),
+		"type":                NullableToString(key.Type),
+		"description":         NullableToString(key.Description),
+		"prefix":              NullableToString(key.Prefix),
+		"api_key":             NullableToString(key.ApiKey),
+		"secret_jwt_template": secretJwtTemplate,
+		"inserted_at":         NullableToTimestamp(key.InsertedAt),
+		"updated_at":          NullableToTimestamp(key.UpdatedAt),
+	})
+}
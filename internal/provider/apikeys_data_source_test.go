@@ -6,6 +6,7 @@ package provider
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/oapi-codegen/nullable"
@@ -19,7 +20,7 @@ func TestAccProjectAPIKeysDataSource(t *testing.T) {
 	defer gock.OffAll()
 	gock.New("https://api.supabase.com").
 		Get("/v1/projects/mayuaycdtijbctgqbycg/api-keys").
-		Times(3).
+		Times(6).
 		Reply(http.StatusOK).
 		JSON([]api.ApiKeyResponse{
 			{
@@ -33,14 +34,26 @@ func TestAccProjectAPIKeysDataSource(t *testing.T) {
 				ApiKey: nullable.NewNullableWithValue("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.service_role"),
 			},
 			{
-				Name:   "publishable",
+				Id:     nullable.NewNullableWithValue("2b1ea2d1-f3a2-4d61-b0a4-5e1b1f3c0a11"),
+				Name:   "default",
 				Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypePublishable),
+				Prefix: nullable.NewNullableWithValue("sb_publishable_eyJhbG"),
 				ApiKey: nullable.NewNullableWithValue("sb_publishable_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
 			},
 			{
-				Name:   "secret",
-				Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypeSecret),
-				ApiKey: nullable.NewNullableWithValue("sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+				Id:     nullable.NewNullableWithValue("2b1ea2d1-f3a2-4d61-b0a4-5e1b1f3c0a12"),
+				Name:   "mobile",
+				Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypePublishable),
+				ApiKey: nullable.NewNullableWithValue("sb_publishable_mobileciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+			},
+			{
+				Id:                nullable.NewNullableWithValue("2b1ea2d1-f3a2-4d61-b0a4-5e1b1f3c0a13"),
+				Name:              "secret",
+				Type:              nullable.NewNullableWithValue(api.ApiKeyResponseTypeSecret),
+				Description:       nullable.NewNullableWithValue("Backend"),
+				ApiKey:            nullable.NewNullableWithValue("sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+				SecretJwtTemplate: nullable.NewNullableWithValue(map[string]interface{}{"role": "service_role"}),
+				InsertedAt:        nullable.NewNullableWithValue(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
 			},
 			{
 				Name:   "other_secret",
@@ -65,8 +78,31 @@ func TestAccProjectAPIKeysDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "secret_keys.0.api_key", "sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
 					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "secret_keys.1.name", "other_secret"),
 					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "secret_keys.1.api_key", "sb_secret_eybcCI6kNiIsiR5UCJ9VGpzI1IciOJhJIXIn"),
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "keys.#", "6"),
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "keys.2.id", "2b1ea2d1-f3a2-4d61-b0a4-5e1b1f3c0a11"),
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "keys.2.prefix", "sb_publishable_eyJhbG"),
+				),
+			},
+			// Filter testing
+			{
+				Config: testAccAPIKeysDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "keys.0.name", "secret"),
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "keys.0.description", "Backend"),
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "keys.0.secret_jwt_template.role", "service_role"),
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "keys.0.inserted_at", "2025-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.supabase_apikeys.production", "publishable_key", "sb_publishable_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
 				),
 			},
 		},
 	})
 }
+
+const testAccAPIKeysDataSourceFilterConfig = `
+data "supabase_apikeys" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  type        = "secret"
+  name        = "secret"
+}
+`
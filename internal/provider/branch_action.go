@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &BranchAction{}
+var _ action.ActionWithConfigure = &BranchAction{}
+
+const branchActionTimeout = 30 * time.Minute
+
+func NewBranchMergeAction() action.Action {
+	return &BranchAction{operation: "merge"}
+}
+
+func NewBranchPushAction() action.Action {
+	return &BranchAction{operation: "push"}
+}
+
+func NewBranchResetAction() action.Action {
+	return &BranchAction{operation: "reset"}
+}
+
+// BranchAction defines the action implementation shared by branch operations.
+type BranchAction struct {
+	client    *api.ClientWithResponses
+	operation string
+}
+
+// BranchActionModel describes the action data model.
+type BranchActionModel struct {
+	BranchId         types.String `tfsdk:"branch_id"`
+	MigrationVersion types.String `tfsdk:"migration_version"`
+}
+
+func (a *BranchAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_" + a.operation
+}
+
+func (a *BranchAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	descriptions := map[string]string{
+		"merge": "Merges the migrations and functions of a branch into its parent project.",
+		"push":  "Pushes the latest changes from the git branch to the database branch.",
+		"reset": "Resets the database branch, discarding all data and re-running migrations.",
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Branch " + a.operation + " action. " + descriptions[a.operation],
+
+		Attributes: map[string]schema.Attribute{
+			"branch_id": schema.StringAttribute{
+				MarkdownDescription: "Branch identifier",
+				Required:            true,
+			},
+			"migration_version": schema.StringAttribute{
+				MarkdownDescription: "Migration version to " + a.operation + " up to, defaults to the latest",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *BranchAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *BranchAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data BranchActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runId, diags := a.startBranchOperation(ctx, data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Started branch %s with action run %s", a.operation, runId),
+	})
+
+	branchRef, diags := readBranchRef(ctx, data.BranchId.ValueString(), a.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForActionRun(ctx, branchRef, runId, a.client, func(msg string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: msg})
+	})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("invoked branch %s action", a.operation))
+}
+
+func (a *BranchAction) startBranchOperation(ctx context.Context, data BranchActionModel) (string, diag.Diagnostics) {
+	branchId := data.BranchId.ValueString()
+	body := api.BranchActionBody{
+		MigrationVersion: data.MigrationVersion.ValueStringPointer(),
+	}
+
+	var result *api.BranchUpdateResponse
+	var status int
+	var respBody []byte
+	var err error
+	switch a.operation {
+	case "merge":
+		var httpResp *api.V1MergeABranchResponse
+		if httpResp, err = a.client.V1MergeABranchWithResponse(ctx, branchId, body); err == nil {
+			result, status, respBody = httpResp.JSON201, httpResp.StatusCode(), httpResp.Body
+		}
+	case "push":
+		var httpResp *api.V1PushABranchResponse
+		if httpResp, err = a.client.V1PushABranchWithResponse(ctx, branchId, body); err == nil {
+			result, status, respBody = httpResp.JSON201, httpResp.StatusCode(), httpResp.Body
+		}
+	case "reset":
+		var httpResp *api.V1ResetABranchResponse
+		if httpResp, err = a.client.V1ResetABranchWithResponse(ctx, branchId, body); err == nil {
+			result, status, respBody = httpResp.JSON201, httpResp.StatusCode(), httpResp.Body
+		}
+	}
+	if err != nil {
+		msg := fmt.Sprintf("Unable to %s branch, got error: %s", a.operation, err)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if result == nil {
+		msg := fmt.Sprintf("Unable to %s branch, got status %d: %s", a.operation, status, respBody)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return result.WorkflowRunId, nil
+}
+
+func readBranchRef(ctx context.Context, branchId string, client *api.ClientWithResponses) (string, diag.Diagnostics) {
+	httpResp, err := client.V1GetABranchConfigWithResponse(ctx, branchId)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read branch, got error: %s", err)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read branch, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return httpResp.JSON200.Ref, nil
+}
+
+func waitForActionRun(ctx context.Context, branchRef, runId string, client *api.ClientWithResponses, progress func(string)) diag.Diagnostics {
+	var failed []string
+	diags := waitFor(ctx, branchActionTimeout, "action run "+runId, func(ctx context.Context) (bool, diag.Diagnostics) {
+		httpResp, err := client.V1GetActionRunWithResponse(ctx, branchRef, runId)
+		if err != nil {
+			msg := fmt.Sprintf("Unable to read action run, got error: %s", err)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		// Action run may not be visible until the workflow is scheduled
+		if httpResp.StatusCode() == http.StatusNotFound {
+			return false, nil
+		}
+		if httpResp.JSON200 == nil {
+			msg := fmt.Sprintf("Unable to read action run, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		steps := httpResp.JSON200.RunSteps
+		if len(steps) == 0 {
+			return false, nil
+		}
+		status := make([]string, 0, len(steps))
+		done := true
+		failed = failed[:0]
+		for _, step := range steps {
+			status = append(status, fmt.Sprintf("%s %s", step.Name, step.Status))
+			switch step.Status {
+			case api.ActionRunResponseRunStepsStatusEXITED:
+			case api.ActionRunResponseRunStepsStatusDEAD:
+				failed = append(failed, string(step.Name))
+			default:
+				done = false
+			}
+		}
+		progress(fmt.Sprintf("Action run %s: %s", runId, strings.Join(status, ", ")))
+		// Later steps never start once a step has died
+		return done || len(failed) > 0, nil
+	})
+	if diags.HasError() || len(failed) == 0 {
+		return diags
+	}
+
+	// Attach the run logs so that migration errors are visible to the user
+	logs := "no logs available"
+	if httpResp, err := client.V1GetActionRunLogsWithResponse(ctx, branchRef, runId); err == nil && httpResp.StatusCode() == http.StatusOK {
+		logs = string(httpResp.Body)
+	}
+	msg := fmt.Sprintf("Action run %s failed at step %s:\n\n%s", runId, strings.Join(failed, ", "), logs)
+	return diag.Diagnostics{diag.NewErrorDiagnostic("Action Run Error", msg)}
+}
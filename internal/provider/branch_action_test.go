@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccBranchMergeAction(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Post("/v1/branches/b2a9cc54-4e5b-4f4a-9c3b-2c1e8d6f0a11/merge").
+		Reply(http.StatusCreated).
+		JSON(api.BranchUpdateResponse{
+			Message:       api.BranchUpdateResponseMessageOk,
+			WorkflowRunId: "run-1",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/branches/b2a9cc54-4e5b-4f4a-9c3b-2c1e8d6f0a11").
+		Reply(http.StatusOK).
+		JSON(api.BranchDetailResponse{
+			Ref:    "kwyqzbpyvdkhjwwkdakg",
+			Status: api.BranchDetailResponseStatusACTIVEHEALTHY,
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg/actions/run-1").
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"id":           "run-1",
+			"branch_id":    "b2a9cc54-4e5b-4f4a-9c3b-2c1e8d6f0a11",
+			"check_run_id": nil,
+			"workdir":      nil,
+			"created_at":   "2025-01-01T00:00:00Z",
+			"updated_at":   "2025-01-01T00:05:00Z",
+			"run_steps": []map[string]any{
+				{"name": "migrate", "status": "EXITED", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:05:00Z"},
+			},
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: examples.BranchMergeActionConfig,
+			},
+		},
+	})
+}
+
+func TestAccBranchResetActionFailed(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Post("/v1/branches/b2a9cc54-4e5b-4f4a-9c3b-2c1e8d6f0a11/reset").
+		Reply(http.StatusCreated).
+		JSON(api.BranchUpdateResponse{
+			Message:       api.BranchUpdateResponseMessageOk,
+			WorkflowRunId: "run-2",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/branches/b2a9cc54-4e5b-4f4a-9c3b-2c1e8d6f0a11").
+		Reply(http.StatusOK).
+		JSON(api.BranchDetailResponse{
+			Ref:    "kwyqzbpyvdkhjwwkdakg",
+			Status: api.BranchDetailResponseStatusACTIVEHEALTHY,
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg/actions/run-2").
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"id":           "run-2",
+			"branch_id":    "b2a9cc54-4e5b-4f4a-9c3b-2c1e8d6f0a11",
+			"check_run_id": nil,
+			"workdir":      nil,
+			"created_at":   "2025-01-01T00:00:00Z",
+			"updated_at":   "2025-01-01T00:05:00Z",
+			"run_steps": []map[string]any{
+				{"name": "migrate", "status": "DEAD", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:05:00Z"},
+				{"name": "seed", "status": "CREATED", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z"},
+			},
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg/actions/run-2/logs").
+		Reply(http.StatusOK).
+		BodyString(`ERROR: relation "todos" already exists`)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBranchResetActionConfig,
+				ExpectError: regexp.MustCompile(`failed at step migrate:\s+ERROR: relation "todos" already exists`),
+			},
+		},
+	})
+}
+
+const testAccBranchResetActionConfig = `
+action "supabase_branch_reset" "staging" {
+  config {
+    branch_id = "b2a9cc54-4e5b-4f4a-9c3b-2c1e8d6f0a11"
+  }
+}
+
+resource "terraform_data" "release" {
+  input = "v1"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.supabase_branch_reset.staging]
+    }
+  }
+}
+`
@@ -7,10 +7,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/supabase/cli/pkg/api"
@@ -30,9 +31,30 @@ type BranchDataSource struct {
 
 // BranchDataSourceModel describes the data source data model.
 type BranchDataSourceModel struct {
+	ParentProjectRef     types.String         `tfsdk:"parent_project_ref"`
+	GitBranch            types.String         `tfsdk:"git_branch"`
+	Id                   types.String         `tfsdk:"id"`
+	ProjectRef           types.String         `tfsdk:"project_ref"`
+	Status               types.String         `tfsdk:"status"`
+	PreviewProjectStatus types.String         `tfsdk:"preview_project_status"`
+	Persistent           types.Bool           `tfsdk:"persistent"`
+	PrNumber             types.Int64          `tfsdk:"pr_number"`
+	CreatedAt            types.String         `tfsdk:"created_at"`
+	UpdatedAt            types.String         `tfsdk:"updated_at"`
+	Database             types.Object         `tfsdk:"database"`
+	Branches             []BranchSummaryModel `tfsdk:"branches"`
+}
+
+// BranchSummaryModel describes a single branch of the parent project.
+type BranchSummaryModel struct {
 	ProjectRef types.String `tfsdk:"project_ref"`
 	GitBranch  types.String `tfsdk:"git_branch"`
 	Id         types.String `tfsdk:"id"`
+	Status     types.String `tfsdk:"status"`
+	Persistent types.Bool   `tfsdk:"persistent"`
+	PrNumber   types.Int64  `tfsdk:"pr_number"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
 }
 
 func (d *BranchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,6 +71,82 @@ func (d *BranchDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "Parent project ref",
 				Required:            true,
 			},
+			"git_branch": schema.StringAttribute{
+				MarkdownDescription: "Git branch to look up. When set, the details of the matching branch are returned in the top level attributes. The default branch, which represents the parent project itself, is never matched; see `supabase_branching` for its git branch.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Branch identifier of the looked up branch",
+				Computed:            true,
+			},
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Branch project ref of the looked up branch",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the looked up branch",
+				Computed:            true,
+			},
+			"preview_project_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the looked up branch project",
+				Computed:            true,
+			},
+			"persistent": schema.BoolAttribute{
+				MarkdownDescription: "Whether the looked up branch is persistent",
+				Computed:            true,
+			},
+			"pr_number": schema.Int64Attribute{
+				MarkdownDescription: "Pull request number of the looked up branch",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp of the looked up branch",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp of the looked up branch",
+				Computed:            true,
+			},
+			"database": schema.SingleNestedAttribute{
+				MarkdownDescription: "Database connection details of the looked up branch",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "Host",
+						Computed:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "Port",
+						Computed:            true,
+					},
+					"user": schema.StringAttribute{
+						MarkdownDescription: "User",
+						Computed:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Password",
+						Sensitive:           true,
+						Computed:            true,
+					},
+					"jwt_secret": schema.StringAttribute{
+						MarkdownDescription: "JWT secret",
+						Sensitive:           true,
+						Computed:            true,
+					},
+					"version": schema.StringAttribute{
+						MarkdownDescription: "Postgres version",
+						Computed:            true,
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Status",
+						Computed:            true,
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Branch project ref",
+						Computed:            true,
+					},
+				},
+			},
 			"branches": schema.SetNestedAttribute{
 				MarkdownDescription: "Branch databases",
 				Computed:            true,
@@ -66,6 +164,26 @@ func (d *BranchDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 							MarkdownDescription: "Branch identifier",
 							Computed:            true,
 						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Branch status",
+							Computed:            true,
+						},
+						"persistent": schema.BoolAttribute{
+							MarkdownDescription: "Whether the branch is persistent",
+							Computed:            true,
+						},
+						"pr_number": schema.Int64Attribute{
+							MarkdownDescription: "Pull request number",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last update timestamp",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -94,10 +212,10 @@ func (d *BranchDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *BranchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var projectRef types.String
+	var data BranchDataSourceModel
 
 	// Read Terraform configuration data into the model
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parent_project_ref"), &projectRef)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -105,7 +223,7 @@ func (d *BranchDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
-	httpResp, err := d.client.V1ListAllBranchesWithResponse(ctx, projectRef.ValueString())
+	httpResp, err := d.client.V1ListAllBranchesWithResponse(ctx, data.ParentProjectRef.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read branch, got error: %s", err)
 		resp.Diagnostics.AddError("Client Error", msg)
@@ -121,22 +239,84 @@ func (d *BranchDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	branches := make([]BranchDataSourceModel, 0)
+	var matches []api.BranchResponse
+	var trackedByDefault bool
+	data.Branches = make([]BranchSummaryModel, 0)
 	for _, branch := range *httpResp.JSON200 {
+		tracked := !data.GitBranch.IsNull() && branch.GitBranch != nil && *branch.GitBranch == data.GitBranch.ValueString()
 		if branch.IsDefault {
+			trackedByDefault = tracked
 			continue
 		}
-		branches = append(branches, BranchDataSourceModel{
+		if !data.GitBranch.IsNull() {
+			if !tracked {
+				continue
+			}
+			matches = append(matches, branch)
+		}
+		prNumber := types.Int64Null()
+		if branch.PrNumber != nil {
+			prNumber = types.Int64Value(int64(*branch.PrNumber))
+		}
+		data.Branches = append(data.Branches, BranchSummaryModel{
 			Id:         types.StringValue(branch.Id.String()),
 			GitBranch:  types.StringPointerValue(branch.GitBranch),
 			ProjectRef: types.StringValue(branch.ProjectRef),
+			Status:     types.StringValue(string(branch.Status)),
+			Persistent: types.BoolValue(branch.Persistent),
+			PrNumber:   prNumber,
+			CreatedAt:  types.StringValue(branch.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:  types.StringValue(branch.UpdatedAt.Format(time.RFC3339)),
 		})
 	}
 
+	data.Database = types.ObjectNull(BranchDatabaseModel{}.AttributeTypes())
+	if !data.GitBranch.IsNull() {
+		switch len(matches) {
+		case 0:
+			msg := fmt.Sprintf("No branch of project %s is tracking git branch %s", data.ParentProjectRef.ValueString(), data.GitBranch.ValueString())
+			if trackedByDefault {
+				msg += ". The git branch is deployed to the default branch, which is not returned by this data source."
+			}
+			resp.Diagnostics.AddError("Branch Not Found", msg)
+			return
+		case 1:
+		default:
+			ids := make([]string, len(matches))
+			for i, branch := range matches {
+				ids[i] = branch.Id.String()
+			}
+			msg := fmt.Sprintf("Multiple branches of project %s are tracking git branch %s: %s", data.ParentProjectRef.ValueString(), data.GitBranch.ValueString(), strings.Join(ids, ", "))
+			resp.Diagnostics.AddError("Ambiguous Branch", msg)
+			return
+		}
+		found := matches[0]
+		summary := data.Branches[0]
+		data.Id = summary.Id
+		data.ProjectRef = summary.ProjectRef
+		data.Status = summary.Status
+		data.Persistent = summary.Persistent
+		data.PrNumber = summary.PrNumber
+		data.CreatedAt = summary.CreatedAt
+		data.UpdatedAt = summary.UpdatedAt
+		if found.PreviewProjectStatus != nil {
+			data.PreviewProjectStatus = types.StringValue(string(*found.PreviewProjectStatus))
+		}
+
+		detail, diags := getBranchDetail(ctx, found.Id.String(), d.client)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		data.Database, diags = branchDatabaseObject(ctx, detail)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "read a data source")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branches"), &branches)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -4,7 +4,10 @@
 package provider
 
 import (
+	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -37,3 +40,102 @@ func TestAccBranchDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBranchDataSourceLookup(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	testBranchUUID := uuid.New()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{{
+			Id:        uuid.New(),
+			GitBranch: Ptr("main"),
+			IsDefault: true,
+		}, {
+			Id:               testBranchUUID,
+			ParentProjectRef: "mayuaycdtijbctgqbycg",
+			ProjectRef:       "kwyqzbpyvdkhjwwkdakg",
+			GitBranch:        Ptr("feature"),
+			Status:           api.BranchResponseStatusMIGRATIONSPASSED,
+			Persistent:       true,
+			PrNumber:         Ptr(int32(42)),
+		}})
+	gock.New("https://api.supabase.com").
+		Get(fmt.Sprintf("/v1/branches/%s", testBranchUUID.String())).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.BranchDetailResponse{
+			Ref:    "kwyqzbpyvdkhjwwkdakg",
+			DbHost: "db.kwyqzbpyvdkhjwwkdakg.supabase.co",
+			DbPort: 5432,
+			DbUser: Ptr("postgres"),
+			DbPass: Ptr("password"),
+			Status: api.BranchDetailResponseStatusACTIVEHEALTHY,
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBranchDataSourceLookupConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_branch.feature", "id", testBranchUUID.String()),
+					resource.TestCheckResourceAttr("data.supabase_branch.feature", "project_ref", "kwyqzbpyvdkhjwwkdakg"),
+					resource.TestCheckResourceAttr("data.supabase_branch.feature", "status", "MIGRATIONS_PASSED"),
+					resource.TestCheckResourceAttr("data.supabase_branch.feature", "persistent", "true"),
+					resource.TestCheckResourceAttr("data.supabase_branch.feature", "pr_number", "42"),
+					resource.TestCheckResourceAttr("data.supabase_branch.feature", "database.host", "db.kwyqzbpyvdkhjwwkdakg.supabase.co"),
+					resource.TestCheckResourceAttr("data.supabase_branch.feature", "database.password", "password"),
+					resource.TestCheckResourceAttr("data.supabase_branch.feature", "branches.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBranchDataSourceLookupConfig = `
+data "supabase_branch" "feature" {
+  parent_project_ref = "mayuaycdtijbctgqbycg"
+  git_branch         = "feature"
+}
+`
+
+func TestAccBranchDataSourceLookupAmbiguous(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{{
+			Id:        uuid.New(),
+			GitBranch: Ptr("main"),
+			IsDefault: true,
+		}, {
+			Id:        uuid.New(),
+			GitBranch: Ptr("feature"),
+		}, {
+			Id:        uuid.New(),
+			GitBranch: Ptr("feature"),
+		}})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBranchDataSourceLookupConfig,
+				ExpectError: regexp.MustCompile(`Multiple branches of project mayuaycdtijbctgqbycg are tracking git branch`),
+			},
+			// The default branch is never matched
+			{
+				Config:      strings.ReplaceAll(testAccBranchDataSourceLookupConfig, `"feature"`, `"main"`),
+				ExpectError: regexp.MustCompile(`deployed to the default branch`),
+			},
+		},
+	})
+}
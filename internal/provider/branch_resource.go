@@ -7,16 +7,23 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/supabase/cli/pkg/api"
 )
@@ -24,6 +31,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BranchResource{}
 var _ resource.ResourceWithImportState = &BranchResource{}
+var _ resource.ResourceWithValidateConfig = &BranchResource{}
+var _ resource.ResourceWithModifyPlan = &BranchResource{}
 
 func NewBranchResource() resource.Resource {
 	return &BranchResource{}
@@ -58,15 +67,73 @@ func (m BranchDatabaseModel) AttributeTypes() map[string]attr.Type {
 	}
 }
 
+type BranchActionRunModel struct {
+	Id        types.String `tfsdk:"id"`
+	Steps     types.List   `tfsdk:"steps"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+var branchActionRunStepAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"status": types.StringType,
+}
+
+func (m BranchActionRunModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"steps":      types.ListType{ElemType: types.ObjectType{AttrTypes: branchActionRunStepAttrTypes}},
+		"created_at": types.StringType,
+		"updated_at": types.StringType,
+	}
+}
+
 // BranchResourceModel describes the resource data model.
 type BranchResourceModel struct {
-	GitBranch        types.String `tfsdk:"git_branch"`
-	ParentProjectRef types.String `tfsdk:"parent_project_ref"`
-	Region           types.String `tfsdk:"region"`
-	Database         types.Object `tfsdk:"database"`
-	Id               types.String `tfsdk:"id"`
+	GitBranch            types.String `tfsdk:"git_branch"`
+	ParentProjectRef     types.String `tfsdk:"parent_project_ref"`
+	Region               types.String `tfsdk:"region"`
+	Persistent           types.Bool   `tfsdk:"persistent"`
+	WithData             types.Bool   `tfsdk:"with_data"`
+	DeletionProtection   types.Bool   `tfsdk:"deletion_protection"`
+	DesiredInstanceSize  types.String `tfsdk:"desired_instance_size"`
+	ReleaseChannel       types.String `tfsdk:"release_channel"`
+	PostgresEngine       types.String `tfsdk:"postgres_engine"`
+	Database             types.Object `tfsdk:"database"`
+	Status               types.String `tfsdk:"status"`
+	PreviewProjectStatus types.String `tfsdk:"preview_project_status"`
+	LatestActionRun      types.Object `tfsdk:"latest_action_run"`
+	Id                   types.String `tfsdk:"id"`
 }
 
+// nullifyUnknown replaces computed values that could not be read with null.
+func (m *BranchResourceModel) nullifyUnknown() {
+	if m.Database.IsUnknown() {
+		m.Database = types.ObjectNull(BranchDatabaseModel{}.AttributeTypes())
+	}
+	if m.Region.IsUnknown() {
+		m.Region = types.StringNull()
+	}
+	if m.ReleaseChannel.IsUnknown() {
+		m.ReleaseChannel = types.StringNull()
+	}
+	if m.PostgresEngine.IsUnknown() {
+		m.PostgresEngine = types.StringNull()
+	}
+	if m.Status.IsUnknown() {
+		m.Status = types.StringNull()
+	}
+	if m.PreviewProjectStatus.IsUnknown() {
+		m.PreviewProjectStatus = types.StringNull()
+	}
+	if m.LatestActionRun.IsUnknown() {
+		m.LatestActionRun = types.ObjectNull(BranchActionRunModel{}.AttributeTypes())
+	}
+}
+
+// branchCreateTimeout bounds how long Create waits for a new branch to be provisioned.
+const branchCreateTimeout = 30 * time.Minute
+
 func (r *BranchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_branch"
 }
@@ -74,7 +141,7 @@ func (r *BranchResource) Metadata(ctx context.Context, req resource.MetadataRequ
 func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Branch database resource",
+		MarkdownDescription: "Branch database resource. Branching must first be enabled on the parent project using `supabase_branching`.",
 
 		Attributes: map[string]schema.Attribute{
 			"git_branch": schema.StringAttribute{
@@ -82,16 +149,87 @@ func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 			},
 			"parent_project_ref": schema.StringAttribute{
-				MarkdownDescription: "Parent project ref",
+				MarkdownDescription: "Parent project ref. Changing it forces a new branch to be created.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						// Branches imported by identifier have no parent project recorded
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Changing the parent project forces a new branch to be created.", "Changing the parent project forces a new branch to be created."),
+				},
 			},
 			"region": schema.StringAttribute{
-				MarkdownDescription: "Database region",
+				MarkdownDescription: "Database region, defaults to the region of the parent project",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"persistent": schema.BoolAttribute{
+				MarkdownDescription: "Whether the branch is persistent rather than an ephemeral preview branch",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"with_data": schema.BoolAttribute{
+				MarkdownDescription: "Whether to clone data from the parent project into the branch",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying or replacing the branch. Only supported on persistent branches.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"desired_instance_size": schema.StringAttribute{
+				MarkdownDescription: "Desired instance size of the branch database, checked against the Management API at plan time. Only used when the branch is created, since the Management API does not report it back, so changes made outside of Terraform are not detected.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"release_channel": schema.StringAttribute{
+				MarkdownDescription: "Release channel of the branch database, defaults to `ga`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.CreateBranchBodyReleaseChannelAlpha),
+						string(api.CreateBranchBodyReleaseChannelBeta),
+						string(api.CreateBranchBodyReleaseChannelGa),
+						string(api.CreateBranchBodyReleaseChannelInternal),
+						string(api.CreateBranchBodyReleaseChannelPreview),
+						string(api.CreateBranchBodyReleaseChannelWithdrawn),
+					),
+				},
+			},
+			"postgres_engine": schema.StringAttribute{
+				MarkdownDescription: "Postgres engine of the branch database, defaults to the latest version",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.CreateBranchBodyPostgresEngineN15),
+						string(api.CreateBranchBodyPostgresEngineN17),
+						string(api.CreateBranchBodyPostgresEngineN17Oriole),
+					),
+				},
+			},
 			"database": schema.SingleNestedAttribute{
 				MarkdownDescription: "Database connection details",
 				Computed:            true,
@@ -135,6 +273,48 @@ func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Branch status, e.g. `CREATING_PROJECT`, `MIGRATIONS_PASSED` or `FUNCTIONS_DEPLOYED`",
+				Computed:            true,
+			},
+			"preview_project_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the branch project, e.g. `COMING_UP` or `ACTIVE_HEALTHY`",
+				Computed:            true,
+			},
+			"latest_action_run": schema.SingleNestedAttribute{
+				MarkdownDescription: "Latest action run on the branch",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Action run identifier",
+						Computed:            true,
+					},
+					"steps": schema.ListNestedAttribute{
+						MarkdownDescription: "Steps of the action run",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Step name",
+									Computed:            true,
+								},
+								"status": schema.StringAttribute{
+									MarkdownDescription: "Step status",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"created_at": schema.StringAttribute{
+						MarkdownDescription: "Creation timestamp",
+						Computed:            true,
+					},
+					"updated_at": schema.StringAttribute{
+						MarkdownDescription: "Last update timestamp",
+						Computed:            true,
+					},
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Branch identifier",
 				Computed:            true,
@@ -146,6 +326,91 @@ func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *BranchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BranchResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ephemeral preview branches are expected to come and go with pull requests
+	if data.DeletionProtection.ValueBool() && !data.Persistent.IsUnknown() && !data.Persistent.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_protection"),
+			"Invalid Attribute Combination",
+			"Deletion protection can only be enabled on persistent branches. Set `persistent = true` as well.",
+		)
+	}
+}
+
+func (r *BranchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state BranchResourceModel
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	}
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// New branches only need their instance size checked
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(checkBranchInstanceSize(ctx, &plan, r.client)...)
+		return
+	}
+	if !req.Plan.Raw.IsNull() && !branchRequiresReplace(plan, state) {
+		return
+	}
+	// Protected branches fail at plan time instead of during apply
+	resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "Branch", state.Id.ValueString())...)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+	if !plan.DesiredInstanceSize.Equal(state.DesiredInstanceSize) {
+		resp.Diagnostics.Append(checkBranchInstanceSize(ctx, &plan, r.client)...)
+	}
+}
+
+// branchRequiresReplace reports whether the plan replaces the branch. Attribute
+// plan modifiers don't expose their RequiresReplace results to ModifyPlan, so
+// this mirrors the replace conditions declared in the schema.
+func branchRequiresReplace(plan, state BranchResourceModel) bool {
+	return (!state.ParentProjectRef.IsNull() && !plan.ParentProjectRef.Equal(state.ParentProjectRef)) ||
+		!plan.Region.Equal(state.Region) ||
+		!plan.WithData.Equal(state.WithData) ||
+		!plan.DesiredInstanceSize.Equal(state.DesiredInstanceSize) ||
+		!plan.ReleaseChannel.Equal(state.ReleaseChannel) ||
+		!plan.PostgresEngine.Equal(state.PostgresEngine)
+}
+
+// checkBranchInstanceSize validates the desired instance size against the
+// sizes the API accepts for the organization of the parent project.
+func checkBranchInstanceSize(ctx context.Context, plan *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	if plan.DesiredInstanceSize.IsUnknown() || plan.DesiredInstanceSize.IsNull() || plan.ParentProjectRef.IsUnknown() {
+		return nil
+	}
+
+	httpResp, err := client.V1GetProjectWithResponse(ctx, plan.ParentProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read parent project, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read parent project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	params := api.V1GetAvailableRegionsParams{
+		OrganizationSlug:    httpResp.JSON200.OrganizationSlug,
+		DesiredInstanceSize: Ptr(api.V1GetAvailableRegionsParamsDesiredInstanceSize(plan.DesiredInstanceSize.ValueString())),
+	}
+	_, diags := listAvailableRegions(ctx, params, client)
+	return diags
+}
+
 func (r *BranchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -174,8 +439,13 @@ func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(createBranch(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ParentProjectRef.ValueString(), r.client, createBranch(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
+		// Save the created branch so that Terraform taints it instead of leaving it orphaned
+		if !data.Id.IsUnknown() {
+			data.nullifyUnknown()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
 		return
 	}
 
@@ -196,7 +466,7 @@ func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	resp.Diagnostics.Append(readBranch(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ParentProjectRef.ValueString(), r.client, readBranch(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -214,7 +484,7 @@ func (r *BranchResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(updateBranch(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ParentProjectRef.ValueString(), r.client, updateBranch(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -232,18 +502,68 @@ func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "Branch", data.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(deleteBranch(ctx, &data, r.client)...)
 }
 
 func (r *BranchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), types.BoolValue(false))...)
+	parentRef, gitBranch, found := strings.Cut(req.ID, "/")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	if parentRef == "" || gitBranch == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <branch_id> or <parent_project_ref>/<git_branch>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	branches, diags := listBranches(ctx, parentRef, r.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	var matches []api.BranchResponse
+	for _, branch := range branches {
+		if branch.GitBranch != nil && *branch.GitBranch == gitBranch {
+			matches = append(matches, branch)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("No branch of project %s is tracking git branch %s", parentRef, gitBranch))
+		return
+	case 1:
+	default:
+		ids := make([]string, len(matches))
+		for i, branch := range matches {
+			ids[i] = branch.Id.String()
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Multiple branches of project %s are tracking git branch %s, import by one of the branch IDs instead: %s", parentRef, gitBranch, strings.Join(ids, ", ")))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(matches[0].Id.String()))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_project_ref"), types.StringValue(parentRef))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("git_branch"), types.StringValue(gitBranch))...)
 }
 
 func updateBranch(ctx context.Context, plan *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
-	httpResp, err := client.V1UpdateABranchConfigWithResponse(ctx, plan.Id.ValueString(), api.UpdateBranchBody{
-		BranchName: plan.GitBranch.ValueStringPointer(),
-		GitBranch:  plan.GitBranch.ValueStringPointer(),
-	})
+	var body api.UpdateBranchBody
+	if !plan.GitBranch.IsUnknown() && !plan.GitBranch.IsNull() {
+		body.BranchName = plan.GitBranch.ValueStringPointer()
+		body.GitBranch = plan.GitBranch.ValueStringPointer()
+	}
+	if !plan.Persistent.IsUnknown() && !plan.Persistent.IsNull() {
+		body.Persistent = plan.Persistent.ValueBoolPointer()
+	}
+	httpResp, err := client.V1UpdateABranchConfigWithResponse(ctx, plan.Id.ValueString(), body)
 	if err != nil {
 		msg := fmt.Sprintf("Unable to update branch, got error: %s", err)
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
@@ -255,70 +575,207 @@ func updateBranch(ctx context.Context, plan *BranchResourceModel, client *api.Cl
 
 	plan.ParentProjectRef = types.StringValue(httpResp.JSON200.ParentProjectRef)
 	plan.GitBranch = types.StringPointerValue(httpResp.JSON200.GitBranch)
-	if diag := readBranchDatabase(ctx, plan, client); diag.HasError() {
-		for _, err := range diag.Errors() {
-			tflog.Warn(ctx, fmt.Sprintf("%s: %s", err.Summary(), err.Detail()))
+	plan.Persistent = types.BoolValue(httpResp.JSON200.Persistent)
+	return readBranch(ctx, plan, client)
+}
+
+func readBranch(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	state.Status = types.StringNull()
+	state.PreviewProjectStatus = types.StringNull()
+	// Branches imported by identifier don't know their parent project until the next apply
+	if !state.ParentProjectRef.IsNull() {
+		branch, diags := findBranch(ctx, state, client)
+		if diags.HasError() {
+			return diags
 		}
+		if branch != nil {
+			setBranchStatus(state, branch)
+		}
+	}
+
+	diags := readBranchDatabase(ctx, state, client)
+	if diags.HasError() {
+		return diags
+	}
+	var database BranchDatabaseModel
+	diags.Append(state.Database.As(ctx, &database, basetypes.ObjectAsOptions{})...)
+	diags.Append(readBranchRegion(ctx, state, database.Id.ValueString(), client)...)
+	diags.Append(readBranchActionRun(ctx, state, database.Id.ValueString(), client)...)
+	return diags
+}
+
+func readBranchRegion(ctx context.Context, state *BranchResourceModel, branchRef string, client *api.ClientWithResponses) diag.Diagnostics {
+	httpResp, err := client.V1GetProjectWithResponse(ctx, branchRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read branch project, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read branch project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
+
+	state.Region = types.StringValue(httpResp.JSON200.Region)
 	return nil
 }
 
-func readBranch(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
-	return readBranchDatabase(ctx, state, client)
+// findBranch looks up the branch among all branches of its parent project, returning
+// nil if the branch no longer exists.
+func findBranch(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) (*api.BranchResponse, diag.Diagnostics) {
+	httpResp, err := client.V1ListAllBranchesWithResponse(ctx, state.ParentProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read branch, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read branch, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	for _, branch := range *httpResp.JSON200 {
+		if branch.Id.String() == state.Id.ValueString() {
+			return &branch, nil
+		}
+	}
+	return nil, nil
+}
+
+func setBranchStatus(state *BranchResourceModel, branch *api.BranchResponse) {
+	if branch.GitBranch != nil {
+		state.GitBranch = types.StringValue(*branch.GitBranch)
+	}
+	state.Persistent = types.BoolValue(branch.Persistent)
+	state.WithData = types.BoolValue(branch.WithData)
+	state.Status = types.StringValue(string(branch.Status))
+	if branch.PreviewProjectStatus != nil {
+		state.PreviewProjectStatus = types.StringValue(string(*branch.PreviewProjectStatus))
+	}
+}
+
+func readBranchActionRun(ctx context.Context, state *BranchResourceModel, branchRef string, client *api.ClientWithResponses) diag.Diagnostics {
+	state.LatestActionRun = types.ObjectNull(BranchActionRunModel{}.AttributeTypes())
+
+	httpResp, err := client.V1ListActionRunsWithResponse(ctx, branchRef, &api.V1ListActionRunsParams{})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read branch action runs, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read branch action runs, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	runs := *httpResp.JSON200
+	if len(runs) == 0 {
+		return nil
+	}
+	// Timestamps are ISO 8601 formatted so they can be compared as strings
+	latest := runs[0]
+	for _, run := range runs[1:] {
+		if run.CreatedAt > latest.CreatedAt {
+			latest = run
+		}
+	}
+
+	steps := make([]attr.Value, 0, len(latest.RunSteps))
+	for _, step := range latest.RunSteps {
+		obj, diags := types.ObjectValue(branchActionRunStepAttrTypes, map[string]attr.Value{
+			"name":   types.StringValue(string(step.Name)),
+			"status": types.StringValue(string(step.Status)),
+		})
+		if diags.HasError() {
+			return diags
+		}
+		steps = append(steps, obj)
+	}
+	stepList, diags := types.ListValue(types.ObjectType{AttrTypes: branchActionRunStepAttrTypes}, steps)
+	if diags.HasError() {
+		return diags
+	}
+
+	run := BranchActionRunModel{
+		Id:        types.StringValue(latest.Id),
+		Steps:     stepList,
+		CreatedAt: types.StringValue(latest.CreatedAt),
+		UpdatedAt: types.StringValue(latest.UpdatedAt),
+	}
+	state.LatestActionRun, diags = types.ObjectValueFrom(ctx, run.AttributeTypes(), run)
+	return diags
 }
 
 func readBranchDatabase(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
-	httpResp, err := client.V1GetABranchConfigWithResponse(ctx, state.Id.ValueString())
+	detail, diags := getBranchDetail(ctx, state.Id.ValueString(), client)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.ReleaseChannel = types.StringValue(detail.ReleaseChannel)
+	state.PostgresEngine = types.StringValue(detail.PostgresEngine)
+	state.Database, diags = branchDatabaseObject(ctx, detail)
+	return diags
+}
+
+func getBranchDetail(ctx context.Context, branchId string, client *api.ClientWithResponses) (*api.BranchDetailResponse, diag.Diagnostics) {
+	httpResp, err := client.V1GetABranchConfigWithResponse(ctx, branchId)
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read branch database, got error: %s", err)
-		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
 	if httpResp.JSON200 == nil {
 		msg := fmt.Sprintf("Unable to read branch database, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
-		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
+	return httpResp.JSON200, nil
+}
 
+func branchDatabaseObject(ctx context.Context, detail *api.BranchDetailResponse) (types.Object, diag.Diagnostics) {
 	database := BranchDatabaseModel{
-		Id:        types.StringValue(httpResp.JSON200.Ref),
-		Host:      types.StringValue(httpResp.JSON200.DbHost),
-		Port:      types.Int64Value(int64(httpResp.JSON200.DbPort)),
-		User:      types.StringPointerValue(httpResp.JSON200.DbUser),
-		Password:  types.StringPointerValue(httpResp.JSON200.DbPass),
-		JwtSecret: types.StringPointerValue(httpResp.JSON200.JwtSecret),
-		Version:   types.StringValue(httpResp.JSON200.PostgresVersion),
-		Status:    types.StringValue(string(httpResp.JSON200.Status)),
+		Id:        types.StringValue(detail.Ref),
+		Host:      types.StringValue(detail.DbHost),
+		Port:      types.Int64Value(int64(detail.DbPort)),
+		User:      types.StringPointerValue(detail.DbUser),
+		Password:  types.StringPointerValue(detail.DbPass),
+		JwtSecret: types.StringPointerValue(detail.JwtSecret),
+		Version:   types.StringValue(detail.PostgresVersion),
+		Status:    types.StringValue(string(detail.Status)),
 	}
-	db, diag := types.ObjectValueFrom(ctx, database.AttributeTypes(), database)
-	state.Database = db
-	return diag
+	return types.ObjectValueFrom(ctx, database.AttributeTypes(), database)
 }
 
 func createBranch(ctx context.Context, plan *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
-	resp, err := client.V1ListAllBranches(ctx, plan.ParentProjectRef.ValueString())
-	if err != nil {
-		msg := fmt.Sprintf("Unable to enable branching, got error: %s", err)
-		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	branches, diags := listBranches(ctx, plan.ParentProjectRef.ValueString(), client)
+	if diags.HasError() {
+		return diags
 	}
-	// 1. Enable branching
-	if resp.StatusCode == http.StatusUnprocessableEntity {
-		httpResp, err := client.V1CreateABranchWithResponse(ctx, plan.ParentProjectRef.ValueString(), api.CreateBranchBody{
-			BranchName: "Production",
-		})
-		if err != nil {
-			msg := fmt.Sprintf("Unable to enable branching, got error: %s", err)
-			return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
-		}
-		if httpResp.JSON201 == nil {
-			msg := fmt.Sprintf("Unable to enable branching, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
-			return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
-		}
+	if branches == nil {
+		msg := fmt.Sprintf("Branching is not enabled on project %s. Enable it with a supabase_branching resource before creating branches.", plan.ParentProjectRef.ValueString())
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Branching Disabled", msg)}
 	}
-	// 2. Create branch database
-	httpResp, err := client.V1CreateABranchWithResponse(ctx, plan.ParentProjectRef.ValueString(), api.CreateBranchBody{
+	body := api.CreateBranchBody{
 		BranchName: plan.GitBranch.ValueString(),
-		GitBranch:  plan.GitBranch.ValueStringPointer(),
-		Region:     plan.Region.ValueStringPointer(),
-	})
+	}
+	if !plan.GitBranch.IsUnknown() && !plan.GitBranch.IsNull() {
+		body.GitBranch = plan.GitBranch.ValueStringPointer()
+	}
+	if !plan.Persistent.IsUnknown() && !plan.Persistent.IsNull() {
+		body.Persistent = plan.Persistent.ValueBoolPointer()
+	}
+	if !plan.WithData.IsUnknown() && !plan.WithData.IsNull() {
+		body.WithData = plan.WithData.ValueBoolPointer()
+	}
+	if !plan.Region.IsUnknown() && !plan.Region.IsNull() {
+		body.Region = plan.Region.ValueStringPointer()
+	}
+	if !plan.DesiredInstanceSize.IsNull() {
+		body.DesiredInstanceSize = Ptr(api.CreateBranchBodyDesiredInstanceSize(plan.DesiredInstanceSize.ValueString()))
+	}
+	if !plan.ReleaseChannel.IsUnknown() && !plan.ReleaseChannel.IsNull() {
+		body.ReleaseChannel = Ptr(api.CreateBranchBodyReleaseChannel(plan.ReleaseChannel.ValueString()))
+	}
+	if !plan.PostgresEngine.IsUnknown() && !plan.PostgresEngine.IsNull() {
+		body.PostgresEngine = Ptr(api.CreateBranchBodyPostgresEngine(plan.PostgresEngine.ValueString()))
+	}
+	httpResp, err := client.V1CreateABranchWithResponse(ctx, plan.ParentProjectRef.ValueString(), body)
 	if err != nil {
 		msg := fmt.Sprintf("Unable to create branch, got error: %s", err)
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
@@ -329,12 +786,39 @@ func createBranch(ctx context.Context, plan *BranchResourceModel, client *api.Cl
 	}
 	// Update computed fields
 	plan.Id = types.StringValue(httpResp.JSON201.Id.String())
-	if diag := readBranchDatabase(ctx, plan, client); diag.HasError() {
-		for _, err := range diag.Errors() {
-			tflog.Warn(ctx, fmt.Sprintf("%s: %s", err.Summary(), err.Detail()))
-		}
+	if diags := waitForBranch(ctx, plan, client); diags.HasError() {
+		return diags
 	}
-	return nil
+	return readBranch(ctx, plan, client)
+}
+
+// waitForBranch polls the branch until migrations have run on its database,
+// failing early if provisioning reports an error.
+func waitForBranch(ctx context.Context, plan *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	return waitFor(ctx, branchCreateTimeout, "branch to be provisioned", func(ctx context.Context) (bool, diag.Diagnostics) {
+		branch, diags := findBranch(ctx, plan, client)
+		if diags.HasError() {
+			return false, diags
+		}
+		if branch == nil {
+			msg := fmt.Sprintf("Branch %s was removed while waiting for it to be provisioned", plan.Id.ValueString())
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("branch status: %s", branch.Status))
+		switch branch.Status {
+		case api.BranchResponseStatusMIGRATIONSPASSED, api.BranchResponseStatusFUNCTIONSDEPLOYED:
+			return true, nil
+		case api.BranchResponseStatusMIGRATIONSFAILED, api.BranchResponseStatusFUNCTIONSFAILED:
+			msg := fmt.Sprintf("Branch %s failed to provision with status %s", plan.Id.ValueString(), branch.Status)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Provisioning Error", msg)}
+		}
+		if branch.PreviewProjectStatus != nil && *branch.PreviewProjectStatus == api.BranchResponsePreviewProjectStatusINITFAILED {
+			msg := fmt.Sprintf("Branch %s failed to provision with project status %s", plan.Id.ValueString(), *branch.PreviewProjectStatus)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Provisioning Error", msg)}
+		}
+		return false, nil
+	})
 }
 
 func deleteBranch(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
@@ -6,6 +6,7 @@ package provider
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -22,59 +23,83 @@ func TestAccBranchResource(t *testing.T) {
 	testBranchUUID := uuid.New()
 	gock.New("https://api.supabase.com").
 		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
-		Reply(http.StatusUnprocessableEntity)
-	gock.New("https://api.supabase.com").
-		Post("/v1/projects/mayuaycdtijbctgqbycg/branches").
-		Reply(http.StatusCreated).
-		JSON(api.BranchResponse{
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{{
 			Id:               uuid.New(),
 			ParentProjectRef: "mayuaycdtijbctgqbycg",
 			IsDefault:        true,
-		})
+		}})
 	gock.New("https://api.supabase.com").
 		Post("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		AddMatcher(testAccBodyContains(`"git_branch":"main"`, `"persistent":false`, `"with_data":false`)).
 		Reply(http.StatusCreated).
 		JSON(api.BranchResponse{
 			Id:               testBranchUUID,
 			ParentProjectRef: "mayuaycdtijbctgqbycg",
 			GitBranch:        Ptr("main"),
+			Status:           api.BranchResponseStatusCREATINGPROJECT,
 		})
-
-	testBranchIDEndpoint := fmt.Sprintf("/v1/branches/%s", testBranchUUID.String())
-	gock.New("https://api.supabase.com").
-		Get(testBranchIDEndpoint).
-		Reply(http.StatusOK).
-		JSON(api.BranchDetailResponse{})
 	gock.New("https://api.supabase.com").
-		Get(testBranchIDEndpoint).
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Persist().
 		Reply(http.StatusOK).
-		JSON(api.BranchDetailResponse{})
-	// Step 2: read
+		JSON([]api.BranchResponse{{
+			Id:                   testBranchUUID,
+			ParentProjectRef:     "mayuaycdtijbctgqbycg",
+			ProjectRef:           "kwyqzbpyvdkhjwwkdakg",
+			GitBranch:            Ptr("main"),
+			Status:               api.BranchResponseStatusMIGRATIONSPASSED,
+			PreviewProjectStatus: Ptr(api.BranchResponsePreviewProjectStatusACTIVEHEALTHY),
+		}})
+
+	testBranchIDEndpoint := fmt.Sprintf("/v1/branches/%s", testBranchUUID.String())
 	gock.New("https://api.supabase.com").
 		Get(testBranchIDEndpoint).
+		Persist().
 		Reply(http.StatusOK).
-		JSON(api.BranchDetailResponse{})
+		JSON(api.BranchDetailResponse{
+			Ref:            "kwyqzbpyvdkhjwwkdakg",
+			DbHost:         "db.kwyqzbpyvdkhjwwkdakg.supabase.co",
+			DbPort:         5432,
+			Status:         api.BranchDetailResponseStatusACTIVEHEALTHY,
+			PostgresEngine: "15",
+			ReleaseChannel: "ga",
+		})
 	gock.New("https://api.supabase.com").
-		Get(testBranchIDEndpoint).
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg").
+		Persist().
 		Reply(http.StatusOK).
-		JSON(api.BranchDetailResponse{})
-	// Step 3: update and read
+		JSON(api.V1ProjectResponse{
+			Id:             "kwyqzbpyvdkhjwwkdakg",
+			Name:           "main",
+			OrganizationId: "continued-brown-smelt",
+			Region:         "us-east-1",
+		})
 	gock.New("https://api.supabase.com").
-		Get(testBranchIDEndpoint).
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg/actions").
+		Persist().
 		Reply(http.StatusOK).
-		JSON(api.BranchDetailResponse{})
+		JSON([]map[string]any{{
+			"id":           "run-1",
+			"branch_id":    testBranchUUID.String(),
+			"check_run_id": nil,
+			"workdir":      nil,
+			"created_at":   "2025-01-01T00:00:00Z",
+			"updated_at":   "2025-01-01T00:05:00Z",
+			"run_steps": []map[string]any{
+				{"name": "migrate", "status": "EXITED", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:05:00Z"},
+			},
+		}})
+	// Step 3: update
 	gock.New("https://api.supabase.com").
 		Patch(testBranchIDEndpoint).
+		AddMatcher(testAccBodyContains(`"git_branch":"develop"`, `"persistent":false`)).
 		Reply(http.StatusOK).
 		JSON(api.BranchResponse{
 			Id:               testBranchUUID,
 			ParentProjectRef: "mayuaycdtijbctgqbycg",
 			GitBranch:        Ptr("develop"),
 		})
-	gock.New("https://api.supabase.com").
-		Get(testBranchIDEndpoint).
-		Reply(http.StatusOK).
-		JSON(api.BranchDetailResponse{})
 	// Step 4: delete
 	gock.New("https://api.supabase.com").
 		Delete(testBranchIDEndpoint).
@@ -89,21 +114,37 @@ func TestAccBranchResource(t *testing.T) {
 				Config: examples.BranchResourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("supabase_branch.new", "id", testBranchUUID.String()),
+					resource.TestCheckResourceAttr("supabase_branch.new", "status", "MIGRATIONS_PASSED"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "preview_project_status", "ACTIVE_HEALTHY"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "persistent", "false"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "with_data", "false"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "postgres_engine", "15"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "release_channel", "ga"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "database.host", "db.kwyqzbpyvdkhjwwkdakg.supabase.co"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "latest_action_run.id", "run-1"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "latest_action_run.steps.0.status", "EXITED"),
 				),
 			},
 			// ImportState testing
+			{
+				ResourceName:      "supabase_branch.new",
+				ImportState:       true,
+				ImportStateId:     "mayuaycdtijbctgqbycg/main",
+				ImportStateVerify: true,
+			},
 			{
 				ResourceName:            "supabase_branch.new",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"git_branch", "parent_project_ref"},
+				ImportStateVerifyIgnore: []string{"git_branch", "parent_project_ref", "status", "preview_project_status", "persistent", "with_data"},
 			},
 			// Update and Read testing
 			{
 				Config: testAccBranchResourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("supabase_branch.new", "git_branch", "develop"),
-					// resource.TestCheckResourceAttrSet("supabase_branch.new", "database"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "status", "MIGRATIONS_PASSED"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -111,9 +152,302 @@ func TestAccBranchResource(t *testing.T) {
 	})
 }
 
+func TestAccBranchResourceProvisioningFailed(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	testBranchUUID := uuid.New()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{})
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusCreated).
+		JSON(api.BranchResponse{
+			Id:               testBranchUUID,
+			ParentProjectRef: "mayuaycdtijbctgqbycg",
+			GitBranch:        Ptr("main"),
+			Status:           api.BranchResponseStatusCREATINGPROJECT,
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{{
+			Id:               testBranchUUID,
+			ParentProjectRef: "mayuaycdtijbctgqbycg",
+			GitBranch:        Ptr("main"),
+			Status:           api.BranchResponseStatusMIGRATIONSFAILED,
+		}})
+	// Tainted branch is destroyed
+	gock.New("https://api.supabase.com").
+		Delete(fmt.Sprintf("/v1/branches/%s", testBranchUUID.String())).
+		Reply(http.StatusOK)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      examples.BranchResourceConfig,
+				ExpectError: regexp.MustCompile(`failed to provision with status MIGRATIONS_FAILED`),
+			},
+		},
+	})
+}
+
+func TestAccBranchResourceBranchingDisabled(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusUnprocessableEntity)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      examples.BranchResourceConfig,
+				ExpectError: regexp.MustCompile(`Branching is not enabled on project mayuaycdtijbctgqbycg`),
+			},
+		},
+	})
+}
+
 const testAccBranchResourceConfig = `
 resource "supabase_branch" "new" {
   parent_project_ref = "mayuaycdtijbctgqbycg"
   git_branch         = "develop"
 }
 `
+
+func TestAccBranchResourcePersistent(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	testBranchUUID := uuid.New()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("15.8.1.044", "15"))
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		MatchParam("organization_slug", "continued-brown-smelt").
+		MatchParam("desired_instance_size", "^small$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{})
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		AddMatcher(testAccBodyContains(`"persistent":true`, `"with_data":true`, `"desired_instance_size":"small"`)).
+		Reply(http.StatusCreated).
+		JSON(api.BranchResponse{
+			Id:               testBranchUUID,
+			ParentProjectRef: "mayuaycdtijbctgqbycg",
+			GitBranch:        Ptr("main"),
+			Persistent:       true,
+			WithData:         true,
+			Status:           api.BranchResponseStatusCREATINGPROJECT,
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{{
+			Id:               testBranchUUID,
+			ParentProjectRef: "mayuaycdtijbctgqbycg",
+			ProjectRef:       "kwyqzbpyvdkhjwwkdakg",
+			GitBranch:        Ptr("main"),
+			Persistent:       true,
+			WithData:         true,
+			Status:           api.BranchResponseStatusFUNCTIONSDEPLOYED,
+		}})
+	gock.New("https://api.supabase.com").
+		Get(fmt.Sprintf("/v1/branches/%s", testBranchUUID.String())).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.BranchDetailResponse{
+			Ref:            "kwyqzbpyvdkhjwwkdakg",
+			DbHost:         "db.kwyqzbpyvdkhjwwkdakg.supabase.co",
+			DbPort:         5432,
+			Status:         api.BranchDetailResponseStatusACTIVEHEALTHY,
+			PostgresEngine: "15",
+			ReleaseChannel: "ga",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg/actions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]map[string]any{})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectResponse{
+			Id:             "kwyqzbpyvdkhjwwkdakg",
+			Name:           "main",
+			OrganizationId: "continued-brown-smelt",
+			Region:         "us-east-1",
+		})
+	gock.New("https://api.supabase.com").
+		Delete(fmt.Sprintf("/v1/branches/%s", testBranchUUID.String())).
+		Reply(http.StatusOK)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "supabase_branch" "new" {
+  parent_project_ref    = "mayuaycdtijbctgqbycg"
+  git_branch            = "main"
+  persistent            = true
+  with_data             = true
+  desired_instance_size = "small"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_branch.new", "persistent", "true"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "with_data", "true"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "desired_instance_size", "small"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBranchResourceDeletionProtection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "supabase_branch" "new" {
+  parent_project_ref  = "mayuaycdtijbctgqbycg"
+  git_branch          = "main"
+  deletion_protection = true
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`only be enabled on persistent branches`),
+			},
+		},
+	})
+}
+
+func TestAccBranchResourceDeletionProtectionReplace(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	testBranchUUID := uuid.New()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{})
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusCreated).
+		JSON(api.BranchResponse{
+			Id:               testBranchUUID,
+			ParentProjectRef: "mayuaycdtijbctgqbycg",
+			GitBranch:        Ptr("main"),
+			Persistent:       true,
+			Status:           api.BranchResponseStatusCREATINGPROJECT,
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{{
+			Id:               testBranchUUID,
+			ParentProjectRef: "mayuaycdtijbctgqbycg",
+			ProjectRef:       "kwyqzbpyvdkhjwwkdakg",
+			GitBranch:        Ptr("main"),
+			Persistent:       true,
+			Status:           api.BranchResponseStatusFUNCTIONSDEPLOYED,
+		}})
+	testBranchIDEndpoint := fmt.Sprintf("/v1/branches/%s", testBranchUUID.String())
+	gock.New("https://api.supabase.com").
+		Get(testBranchIDEndpoint).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.BranchDetailResponse{
+			Ref:            "kwyqzbpyvdkhjwwkdakg",
+			DbHost:         "db.kwyqzbpyvdkhjwwkdakg.supabase.co",
+			DbPort:         5432,
+			Status:         api.BranchDetailResponseStatusACTIVEHEALTHY,
+			PostgresEngine: "15",
+			ReleaseChannel: "ga",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg/actions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]map[string]any{})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectResponse{
+			Id:             "kwyqzbpyvdkhjwwkdakg",
+			Name:           "main",
+			OrganizationId: "continued-brown-smelt",
+			Region:         "us-east-1",
+		})
+	gock.New("https://api.supabase.com").
+		Patch(testBranchIDEndpoint).
+		AddMatcher(testAccBodyContains(`"persistent":true`)).
+		Reply(http.StatusOK).
+		JSON(api.BranchResponse{
+			Id:               testBranchUUID,
+			ParentProjectRef: "mayuaycdtijbctgqbycg",
+			GitBranch:        Ptr("main"),
+			Persistent:       true,
+		})
+	gock.New("https://api.supabase.com").
+		Delete(testBranchIDEndpoint).
+		Reply(http.StatusOK)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchResourceProtectedConfig(false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_branch.new", "deletion_protection", "true"),
+				),
+			},
+			// Replacing a protected branch fails at plan time
+			{
+				Config:      testAccBranchResourceProtectedConfig(true, true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`deletion protection enabled`),
+			},
+			// Disable protection so that the branch can be destroyed
+			{
+				Config: testAccBranchResourceProtectedConfig(false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_branch.new", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranchResourceProtectedConfig(withData, protected bool) string {
+	return fmt.Sprintf(`
+resource "supabase_branch" "new" {
+  parent_project_ref  = "mayuaycdtijbctgqbycg"
+  git_branch          = "main"
+  persistent          = true
+  with_data           = %t
+  deletion_protection = %t
+}
+`, withData, protected)
+}
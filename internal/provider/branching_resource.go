@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BranchingResource{}
+var _ resource.ResourceWithImportState = &BranchingResource{}
+
+func NewBranchingResource() resource.Resource {
+	return &BranchingResource{}
+}
+
+// BranchingResource defines the resource implementation.
+type BranchingResource struct {
+	client *api.ClientWithResponses
+}
+
+// BranchingResourceModel describes the resource data model.
+type BranchingResourceModel struct {
+	ProjectRef      types.String `tfsdk:"project_ref"`
+	GitBranch       types.String `tfsdk:"git_branch"`
+	DefaultBranchId types.String `tfsdk:"default_branch_id"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *BranchingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branching"
+}
+
+func (r *BranchingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Branching resource. Enables preview branching on a project and manages its default branch. Destroying this resource disables branching and deletes all preview branches.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"git_branch": schema.StringAttribute{
+				MarkdownDescription: "Git branch that is deployed to the default branch",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_branch_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the default branch representing the project itself",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Project identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BranchingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BranchingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BranchingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.ProjectRef
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, enableBranching(ctx, &data, r.client))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created branching resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BranchingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled, diags := readBranching(ctx, &data, r.client)
+	if resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, diags)...); resp.Diagnostics.HasError() {
+		return
+	}
+	// Branching was disabled outside of Terraform
+	if !enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BranchingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, updateDefaultBranch(ctx, &data, r.client))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BranchingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.V1DisablePreviewBranchingWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable branching, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable branching, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted branching resource")
+}
+
+func (r *BranchingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_ref"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+}
+
+// listBranches returns all branches of a project, or nil if branching is not enabled.
+func listBranches(ctx context.Context, projectRef string, client *api.ClientWithResponses) ([]api.BranchResponse, diag.Diagnostics) {
+	httpResp, err := client.V1ListAllBranchesWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list branches, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusUnprocessableEntity {
+		return nil, nil
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list branches, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return *httpResp.JSON200, nil
+}
+
+func readBranching(ctx context.Context, state *BranchingResourceModel, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+	branches, diags := listBranches(ctx, state.Id.ValueString(), client)
+	if branches == nil {
+		return false, diags
+	}
+
+	state.ProjectRef = state.Id
+	for _, branch := range branches {
+		if branch.IsDefault {
+			state.DefaultBranchId = types.StringValue(branch.Id.String())
+			state.GitBranch = types.StringPointerValue(branch.GitBranch)
+		}
+	}
+	return true, nil
+}
+
+func enableBranching(ctx context.Context, plan *BranchingResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	branches, diags := listBranches(ctx, plan.ProjectRef.ValueString(), client)
+	if diags.HasError() {
+		return diags
+	}
+	// Branching is already enabled so only the default branch needs updating
+	if branches != nil {
+		for _, branch := range branches {
+			if branch.IsDefault {
+				plan.DefaultBranchId = types.StringValue(branch.Id.String())
+				return updateDefaultBranch(ctx, plan, client)
+			}
+		}
+	}
+
+	body := api.CreateBranchBody{
+		BranchName: "Production",
+	}
+	if !plan.GitBranch.IsUnknown() && !plan.GitBranch.IsNull() {
+		body.GitBranch = plan.GitBranch.ValueStringPointer()
+	}
+	httpResp, err := client.V1CreateABranchWithResponse(ctx, plan.ProjectRef.ValueString(), body)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to enable branching, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON201 == nil {
+		msg := fmt.Sprintf("Unable to enable branching, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	plan.DefaultBranchId = types.StringValue(httpResp.JSON201.Id.String())
+	plan.GitBranch = types.StringPointerValue(httpResp.JSON201.GitBranch)
+	return nil
+}
+
+func updateDefaultBranch(ctx context.Context, plan *BranchingResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	if plan.GitBranch.IsUnknown() || plan.GitBranch.IsNull() {
+		_, diags := readBranching(ctx, plan, client)
+		return diags
+	}
+
+	httpResp, err := client.V1UpdateABranchConfigWithResponse(ctx, plan.DefaultBranchId.ValueString(), api.UpdateBranchBody{
+		GitBranch: plan.GitBranch.ValueStringPointer(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to update default branch, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to update default branch, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	plan.GitBranch = types.StringPointerValue(httpResp.JSON200.GitBranch)
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccBranchingResource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	// Step 1: create
+	defaultBranch := api.BranchResponse{
+		Id:               uuid.New(),
+		ParentProjectRef: "mayuaycdtijbctgqbycg",
+		ProjectRef:       "mayuaycdtijbctgqbycg",
+		GitBranch:        Ptr("main"),
+		IsDefault:        true,
+	}
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusUnprocessableEntity)
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		JSON(map[string]any{"branch_name": "Production", "git_branch": "main"}).
+		Reply(http.StatusCreated).
+		JSON(defaultBranch)
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{defaultBranch})
+	// Step 3: delete
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusOK)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.BranchingResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_branching.production", "id", "mayuaycdtijbctgqbycg"),
+					resource.TestCheckResourceAttr("supabase_branching.production", "git_branch", "main"),
+					resource.TestCheckResourceAttr("supabase_branching.production", "default_branch_id", defaultBranch.Id.String()),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_branching.production",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBranchingResourceWithoutGitBranch(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	defaultBranch := api.BranchResponse{
+		Id:               uuid.New(),
+		ParentProjectRef: "mayuaycdtijbctgqbycg",
+		ProjectRef:       "mayuaycdtijbctgqbycg",
+		IsDefault:        true,
+	}
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusUnprocessableEntity)
+	// Unset git branch is omitted rather than sent as an empty string
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		JSON(map[string]any{"branch_name": "Production"}).
+		Reply(http.StatusCreated).
+		JSON(defaultBranch)
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{defaultBranch})
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusOK)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "supabase_branching" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_branching.production", "id", "mayuaycdtijbctgqbycg"),
+					resource.TestCheckNoResourceAttr("supabase_branching.production", "git_branch"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBranchingResourcePausedProject(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/branches").
+		Reply(http.StatusBadRequest).
+		JSON(map[string]any{"message": "Project is not active"})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithStatus(api.V1ProjectWithDatabaseResponseStatusINACTIVE))
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      examples.BranchingResourceConfig,
+				ExpectError: regexp.MustCompile(`Project mayuaycdtijbctgqbycg is INACTIVE`),
+			},
+		},
+	})
+}
@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectionStringDataSource{}
+
+func NewConnectionStringDataSource() datasource.DataSource {
+	return &ConnectionStringDataSource{}
+}
+
+// ConnectionStringDataSource defines the data source implementation.
+type ConnectionStringDataSource struct {
+	client *api.ClientWithResponses
+}
+
+// ConnectionStringDataSourceModel describes the data source data model.
+type ConnectionStringDataSourceModel struct {
+	ProjectRef         types.String `tfsdk:"project_ref"`
+	DatabaseIdentifier types.String `tfsdk:"database_identifier"`
+	Role               types.String `tfsdk:"role"`
+	Mode               types.String `tfsdk:"mode"`
+	SslMode            types.String `tfsdk:"sslmode"`
+	RequireIpv4        types.Bool   `tfsdk:"require_ipv4"`
+	Password           types.String `tfsdk:"password"`
+	Host               types.String `tfsdk:"host"`
+	Port               types.Int64  `tfsdk:"port"`
+	User               types.String `tfsdk:"user"`
+	DbName             types.String `tfsdk:"dbname"`
+	Uri                types.String `tfsdk:"uri"`
+	KeywordValue       types.String `tfsdk:"keyword_value"`
+	Ipv4               types.Bool   `tfsdk:"ipv4"`
+}
+
+const (
+	connectionModeDirect      = "direct"
+	connectionModeSession     = "session"
+	connectionModeTransaction = "transaction"
+)
+
+func (d *ConnectionStringDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_string"
+}
+
+func (d *ConnectionStringDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connection string data source. Composes the connection details for a project or branch database.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project ref, or the project ref of a branch",
+				Required:            true,
+			},
+			"database_identifier": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the database to connect to, defaults to the primary database. Set to a read replica identifier to connect through its pooler.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Database role to connect as, defaults to `postgres`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Connection mode, one of `direct`, `session` or `transaction`. Defaults to `direct`, or to `session` when `require_ipv4` is set and the project has no IPv4 add-on.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectionModeDirect, connectionModeSession, connectionModeTransaction),
+				},
+			},
+			"sslmode": schema.StringAttribute{
+				MarkdownDescription: "SSL mode of the connection, defaults to `require`",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("disable", "allow", "prefer", "require", "verify-ca", "verify-full"),
+				},
+			},
+			"require_ipv4": schema.BoolAttribute{
+				MarkdownDescription: "Whether the connection must be reachable over IPv4. Direct connections are IPv6 only unless the project has the IPv4 add-on, while the pooler always supports IPv4.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the database role. Branch passwords are looked up when not set.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Database host",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Database port",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Database user, including the project suffix required by the pooler",
+				Computed:            true,
+			},
+			"dbname": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				Computed:            true,
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "Connection string in libpq URI format",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"keyword_value": schema.StringAttribute{
+				MarkdownDescription: "Connection string in libpq keyword/value format",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"ipv4": schema.BoolAttribute{
+				MarkdownDescription: "Whether the host is reachable over IPv4",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConnectionStringDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectionStringDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConnectionStringDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DatabaseIdentifier.IsNull() {
+		data.DatabaseIdentifier = data.ProjectRef
+	}
+	if data.Role.IsNull() {
+		data.Role = types.StringValue("postgres")
+	}
+	if data.SslMode.IsNull() {
+		data.SslMode = types.StringValue("require")
+	}
+
+	// Direct connections resolve to IPv6 addresses unless the IPv4 add-on is enabled
+	direct := data.Mode.IsNull() || data.Mode.ValueString() == connectionModeDirect
+	data.Ipv4 = types.BoolValue(!direct)
+	if direct && data.DatabaseIdentifier.Equal(data.ProjectRef) {
+		ipv4, diags := projectHasIpv4(ctx, data.ProjectRef.ValueString(), d.client)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		data.Ipv4 = types.BoolValue(ipv4)
+	}
+	if data.Mode.IsNull() {
+		data.Mode = types.StringValue(connectionModeDirect)
+		// Fall back to the pooler, which is always reachable over IPv4
+		if data.RequireIpv4.ValueBool() && !data.Ipv4.ValueBool() {
+			data.Mode = types.StringValue(connectionModeSession)
+			data.Ipv4 = types.BoolValue(true)
+		}
+	}
+	if data.RequireIpv4.ValueBool() && !data.Ipv4.ValueBool() && data.DatabaseIdentifier.Equal(data.ProjectRef) {
+		msg := fmt.Sprintf("Direct connections to project %s are only reachable over IPv6. Enable the IPv4 add-on with a supabase_project_addon resource, or connect in session mode through the pooler.", data.ProjectRef.ValueString())
+		resp.Diagnostics.AddAttributeError(path.Root("mode"), "IPv4 Not Available", msg)
+		return
+	}
+
+	if data.Mode.ValueString() == connectionModeDirect {
+		resp.Diagnostics.Append(readDirectConnection(ctx, &data, d.client)...)
+	} else {
+		resp.Diagnostics.Append(readPoolerConnection(ctx, &data, d.client)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Password.IsNull() {
+		password, diags := readBranchPassword(ctx, data.ProjectRef.ValueString(), d.client)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		data.Password = password
+	}
+	if data.Password.IsNull() {
+		msg := fmt.Sprintf("Project %s is not a branch, so its database password must be provided with the password attribute.", data.ProjectRef.ValueString())
+		resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing Password", msg)
+		return
+	}
+
+	data.Uri = types.StringValue(connectionUri(data))
+	data.KeywordValue = types.StringValue(connectionKeywordValue(data))
+
+	tflog.Trace(ctx, "read connection string data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func readDirectConnection(ctx context.Context, data *ConnectionStringDataSourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	if data.DatabaseIdentifier.ValueString() != data.ProjectRef.ValueString() {
+		msg := "Direct connections are only available for the primary database, use session or transaction mode to connect to a read replica."
+		return diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("mode"), "Unsupported Connection Mode", msg)}
+	}
+
+	httpResp, err := client.V1GetProjectWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read project, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	data.Host = types.StringValue(httpResp.JSON200.Database.Host)
+	data.Port = types.Int64Value(5432)
+	data.User = data.Role
+	data.DbName = types.StringValue("postgres")
+	return nil
+}
+
+func readPoolerConnection(ctx context.Context, data *ConnectionStringDataSourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	httpResp, err := client.V1GetPoolerConfigWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read pooler, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read pooler, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	var pooler *api.SupavisorConfigResponse
+	for _, p := range *httpResp.JSON200 {
+		if p.Identifier != data.DatabaseIdentifier.ValueString() {
+			continue
+		}
+		// Prefer the entry configured for the requested pool mode
+		if pooler == nil || string(p.PoolMode) == data.Mode.ValueString() {
+			pooler = &p
+		}
+	}
+	if pooler == nil {
+		msg := fmt.Sprintf("No pooler found for database %s", data.DatabaseIdentifier.ValueString())
+		return diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("database_identifier"), "Pooler Not Found", msg)}
+	}
+
+	data.Host = types.StringValue(pooler.DbHost)
+	data.Port = types.Int64Value(int64(pooler.DbPort))
+	// Supavisor serves session mode on the default Postgres port
+	if string(pooler.PoolMode) != data.Mode.ValueString() {
+		if data.Mode.ValueString() == connectionModeSession {
+			data.Port = types.Int64Value(5432)
+		} else {
+			data.Port = types.Int64Value(6543)
+		}
+	}
+	// Supavisor identifies the tenant by the suffix of the user name
+	data.User = types.StringValue(fmt.Sprintf("%s.%s", data.Role.ValueString(), data.ProjectRef.ValueString()))
+	data.DbName = types.StringValue(pooler.DbName)
+	return nil
+}
+
+// projectHasIpv4 reports whether the IPv4 add-on is enabled on a project.
+func projectHasIpv4(ctx context.Context, projectRef string, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+	addons, diags := listProjectAddons(ctx, projectRef, client)
+	if diags.HasError() {
+		return false, diags
+	}
+	for _, addon := range addons.SelectedAddons {
+		if addon.Type == api.Ipv4 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// readBranchPassword returns the database password of a branch, or null if the
+// ref is not a branch. Other API errors are reported rather than treated as a
+// missing branch.
+func readBranchPassword(ctx context.Context, ref string, client *api.ClientWithResponses) (types.String, diag.Diagnostics) {
+	httpResp, err := client.V1GetABranchConfigWithResponse(ctx, ref)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read branch database, got error: %s", err)
+		return types.StringNull(), diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		return types.StringNull(), nil
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read branch database, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return types.StringNull(), diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200.Ref != ref {
+		return types.StringNull(), nil
+	}
+	return types.StringPointerValue(httpResp.JSON200.DbPass), nil
+}
+
+func connectionUri(data ConnectionStringDataSourceModel) string {
+	u := url.URL{
+		Scheme:   "postgresql",
+		User:     url.UserPassword(data.User.ValueString(), data.Password.ValueString()),
+		Host:     fmt.Sprintf("%s:%d", data.Host.ValueString(), data.Port.ValueInt64()),
+		Path:     "/" + data.DbName.ValueString(),
+		RawQuery: url.Values{"sslmode": {data.SslMode.ValueString()}}.Encode(),
+	}
+	return u.String()
+}
+
+func connectionKeywordValue(data ConnectionStringDataSourceModel) string {
+	// Values are single quoted with backslash escapes, as described in libpq docs
+	quote := func(v string) string {
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v) + "'"
+	}
+	return strings.Join([]string{
+		"host=" + quote(data.Host.ValueString()),
+		"port=" + strconv.FormatInt(data.Port.ValueInt64(), 10),
+		"user=" + quote(data.User.ValueString()),
+		"password=" + quote(data.Password.ValueString()),
+		"dbname=" + quote(data.DbName.ValueString()),
+		"sslmode=" + data.SslMode.ValueString(),
+	}, " ")
+}
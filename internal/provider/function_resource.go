@@ -177,7 +177,7 @@ func (r *FunctionResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	resp.Diagnostics.Append(deployFunction(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, deployFunction(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -195,7 +195,7 @@ func (r *FunctionResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(readFunction(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, readFunction(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -213,7 +213,7 @@ func (r *FunctionResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	resp.Diagnostics.Append(deployFunction(ctx, &data, r.client)...)
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, deployFunction(ctx, &data, r.client))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
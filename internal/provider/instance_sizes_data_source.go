@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// computeAddonPrefix prefixes the compute add-on variant of each instance size.
+const computeAddonPrefix = "ci_"
+
+// computeVariantMeta describes the hardware reported for a compute add-on variant.
+type computeVariantMeta struct {
+	CpuCores     *int64   `json:"cpu_cores"`
+	CpuDedicated *bool    `json:"cpu_dedicated"`
+	MemoryGb     *float64 `json:"memory_gb"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InstanceSizesDataSource{}
+
+func NewInstanceSizesDataSource() datasource.DataSource {
+	return &InstanceSizesDataSource{}
+}
+
+// InstanceSizesDataSource defines the data source implementation.
+type InstanceSizesDataSource struct {
+	client *api.ClientWithResponses
+}
+
+// InstanceSizesDataSourceModel describes the data source data model.
+type InstanceSizesDataSourceModel struct {
+	ProjectRef       types.String        `tfsdk:"project_ref"`
+	OrganizationSlug types.String        `tfsdk:"organization_slug"`
+	Region           types.String        `tfsdk:"region"`
+	InstanceSizes    []InstanceSizeModel `tfsdk:"instance_sizes"`
+}
+
+// InstanceSizeModel describes a single compute size a project can run on.
+type InstanceSizeModel struct {
+	Size         types.String  `tfsdk:"size"`
+	Name         types.String  `tfsdk:"name"`
+	CpuCores     types.Int64   `tfsdk:"cpu_cores"`
+	CpuDedicated types.Bool    `tfsdk:"cpu_dedicated"`
+	MemoryGb     types.Float64 `tfsdk:"memory_gb"`
+	Available    types.Bool    `tfsdk:"available"`
+}
+
+func (d *InstanceSizesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_sizes"
+}
+
+func (d *InstanceSizesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Instance sizes data source. Sizes are read from the compute add-on variants offered to a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID used to look up compute add-on variants",
+				Required:            true,
+			},
+			"organization_slug": schema.StringAttribute{
+				MarkdownDescription: "Organization slug used to look up region availability",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Report availability in this region only",
+				Optional:            true,
+			},
+			"instance_sizes": schema.ListNestedAttribute{
+				MarkdownDescription: "Compute sizes a project can run on",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"size": schema.StringAttribute{
+							MarkdownDescription: "Instance size, usable as `instance_size` of `supabase_project`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Instance size display name",
+							Computed:            true,
+						},
+						"cpu_cores": schema.Int64Attribute{
+							MarkdownDescription: "Number of CPU cores, unset when not reported by the API",
+							Computed:            true,
+						},
+						"cpu_dedicated": schema.BoolAttribute{
+							MarkdownDescription: "Whether the CPU cores are dedicated, unset when not reported by the API",
+							Computed:            true,
+						},
+						"memory_gb": schema.Float64Attribute{
+							MarkdownDescription: "Memory in GB, unset when not reported by the API",
+							Computed:            true,
+						},
+						"available": schema.BoolAttribute{
+							MarkdownDescription: "Whether new projects can currently be created in `region`, or in any region when `region` is not set. Capacity is reported per region rather than per size; set `instance_size` on `supabase_regions` to check a single size.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InstanceSizesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InstanceSizesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceSizesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addons, diags := listProjectAddons(ctx, data.ProjectRef.ValueString(), d.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	// Capacity is shared by all sizes, so regions are only listed once
+	params := api.V1GetAvailableRegionsParams{OrganizationSlug: data.OrganizationSlug.ValueString()}
+	regions, diags := listAvailableRegions(ctx, params, d.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	available := false
+	for _, region := range regions.All.Specific {
+		if region.Status == nil && (data.Region.IsNull() || region.Code == data.Region.ValueString()) {
+			available = true
+			break
+		}
+	}
+
+	data.InstanceSizes = []InstanceSizeModel{}
+	for _, addon := range addons.AvailableAddons {
+		if addon.Type != api.ListProjectAddonsResponseAvailableAddonsTypeComputeInstance {
+			continue
+		}
+		for _, variant := range addon.Variants {
+			id, err := variant.Id.AsListProjectAddonsResponseAvailableAddonsVariantsId0()
+			if err != nil {
+				msg := fmt.Sprintf("Unable to read %s addon variants, got error: %s", addon.Type, err)
+				resp.Diagnostics.AddError("Client Error", msg)
+				return
+			}
+			meta := parseComputeVariantMeta(variant.Meta)
+			data.InstanceSizes = append(data.InstanceSizes, InstanceSizeModel{
+				Size:         types.StringValue(strings.TrimPrefix(string(id), computeAddonPrefix)),
+				Name:         types.StringValue(variant.Name),
+				CpuCores:     types.Int64PointerValue(meta.CpuCores),
+				CpuDedicated: types.BoolPointerValue(meta.CpuDedicated),
+				MemoryGb:     types.Float64PointerValue(meta.MemoryGb),
+				Available:    types.BoolValue(available),
+			})
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseComputeVariantMeta reads the hardware of a compute add-on variant. The
+// meta field is free-form, so values of unexpected types are left unset.
+func parseComputeVariantMeta(raw *interface{}) computeVariantMeta {
+	var meta computeVariantMeta
+	if raw == nil {
+		return meta
+	}
+	body, err := json.Marshal(*raw)
+	if err != nil {
+		return meta
+	}
+	// Mismatched fields are skipped while the remaining ones are still decoded
+	_ = json.Unmarshal(body, &meta)
+	return meta
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccInstanceSizesDataSource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons": []map[string]any{},
+			"available_addons": []map[string]any{{
+				"name": "Optimized Compute",
+				"type": "compute_instance",
+				"variants": []map[string]any{{
+					"id":    "ci_micro",
+					"name":  "Micro",
+					"meta":  map[string]any{"cpu_cores": 2, "cpu_dedicated": false, "memory_gb": 1},
+					"price": map[string]any{"amount": 0.01344, "description": "$0.01344/hour", "interval": "hourly", "type": "usage"},
+				}, {
+					"id":    "ci_2xlarge",
+					"name":  "2XL",
+					"meta":  map[string]any{"cpu_cores": 8, "cpu_dedicated": true, "memory_gb": 32},
+					"price": map[string]any{"amount": 0.562, "description": "$0.562/hour", "interval": "hourly", "type": "usage"},
+				}, {
+					"id":    "ci_nano",
+					"name":  "Nano",
+					"meta":  map[string]any{"cpu_cores": "shared", "memory_gb": 0.5},
+					"price": map[string]any{"amount": 0, "description": "$0/hour", "interval": "hourly", "type": "usage"},
+				}},
+			}, {
+				"name": "Point in time recovery",
+				"type": "pitr",
+				"variants": []map[string]any{{
+					"id":    "pitr_7",
+					"name":  "7 days",
+					"price": map[string]any{"amount": 100, "description": "$100/month", "interval": "monthly", "type": "fixed"},
+				}},
+			}},
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		MatchParam("organization_slug", "continued-brown-smelt").
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: examples.InstanceSizesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.#", "3"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.0.size", "micro"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.0.name", "Micro"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.0.cpu_cores", "2"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.0.cpu_dedicated", "false"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.0.memory_gb", "1"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.0.available", "true"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.1.size", "2xlarge"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.1.cpu_dedicated", "true"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.2.size", "nano"),
+					resource.TestCheckNoResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.2.cpu_cores"),
+					resource.TestCheckResourceAttr("data.supabase_instance_sizes.production", "instance_sizes.2.memory_gb", "0.5"),
+				),
+			},
+		},
+	})
+}
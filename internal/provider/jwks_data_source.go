@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JwksDataSource{}
+
+func NewJwksDataSource() datasource.DataSource {
+	return &JwksDataSource{}
+}
+
+// JwksDataSource defines the data source implementation.
+type JwksDataSource struct {
+	client *api.ClientWithResponses
+}
+
+// JwksDataSourceModel describes the data source data model.
+type JwksDataSourceModel struct {
+	ProjectRef types.String         `tfsdk:"project_ref"`
+	Keys       []JwksKeyModel       `tfsdk:"keys"`
+	Jwks       jsontypes.Normalized `tfsdk:"jwks"`
+}
+
+// JwksKeyModel describes a single signing key of the project.
+type JwksKeyModel struct {
+	Id        types.String         `tfsdk:"id"`
+	Algorithm types.String         `tfsdk:"algorithm"`
+	Status    types.String         `tfsdk:"status"`
+	PublicJwk jsontypes.Normalized `tfsdk:"public_jwk"`
+	CreatedAt types.String         `tfsdk:"created_at"`
+	UpdatedAt types.String         `tfsdk:"updated_at"`
+}
+
+func (d *JwksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwks"
+}
+
+func (d *JwksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "JSON Web Key Set data source",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+			},
+			"keys": schema.ListNestedAttribute{
+				MarkdownDescription: "All JWT signing keys of the project",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Signing key identifier",
+							Computed:            true,
+						},
+						"algorithm": schema.StringAttribute{
+							MarkdownDescription: "Signing algorithm of the key",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the key",
+							Computed:            true,
+						},
+						"public_jwk": schema.StringAttribute{
+							CustomType:          jsontypes.NormalizedType{},
+							MarkdownDescription: "Public key as serialised JWK, empty for symmetric keys",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last update timestamp",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"jwks": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
+				MarkdownDescription: "Serialised JWKS containing the public keys that are not revoked",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JwksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JwksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JwksDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.client.V1GetProjectSigningKeysWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read signing keys, got error: %s", err))
+		return
+	}
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read signing keys, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	data.Keys = make([]JwksKeyModel, 0, len(httpResp.JSON200.Keys))
+	publicKeys := make([]any, 0, len(httpResp.JSON200.Keys))
+	for _, key := range httpResp.JSON200.Keys {
+		publicJwk, err := parsePublicJwk(key.PublicJwk)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse public jwk, got error: %s", err))
+			return
+		}
+		data.Keys = append(data.Keys, JwksKeyModel{
+			Id:        types.StringValue(key.Id.String()),
+			Algorithm: types.StringValue(string(key.Algorithm)),
+			Status:    types.StringValue(string(key.Status)),
+			PublicJwk: publicJwk,
+			CreatedAt: types.StringValue(key.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt: types.StringValue(key.UpdatedAt.Format(time.RFC3339)),
+		})
+		// Revoked keys must no longer be trusted by verifiers
+		if key.Status != api.SigningKeysResponseKeysStatusRevoked && !publicJwk.IsNull() {
+			publicKeys = append(publicKeys, key.PublicJwk.MustGet())
+		}
+	}
+
+	jwks, err := json.Marshal(map[string]any{"keys": publicKeys})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode jwks, got error: %s", err))
+		return
+	}
+	data.Jwks = jsontypes.NewNormalizedValue(string(jwks))
+
+	tflog.Trace(ctx, "read jwks")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccJwksDataSource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/config/auth/signing-keys").
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"keys": []map[string]any{
+				{
+					"id":         "0b2cbc2e-7d3f-4c4b-9d1f-1f4ef7b9a001",
+					"algorithm":  "ES256",
+					"status":     "in_use",
+					"public_jwk": map[string]any{"kty": "EC", "crv": "P-256", "kid": "0b2cbc2e-7d3f-4c4b-9d1f-1f4ef7b9a001"},
+					"created_at": "2025-01-01T00:00:00Z",
+					"updated_at": "2025-01-01T00:00:00Z",
+				},
+				{
+					"id":         "0b2cbc2e-7d3f-4c4b-9d1f-1f4ef7b9a002",
+					"algorithm":  "ES256",
+					"status":     "revoked",
+					"public_jwk": map[string]any{"kty": "EC", "crv": "P-256", "kid": "0b2cbc2e-7d3f-4c4b-9d1f-1f4ef7b9a002"},
+					"created_at": "2024-01-01T00:00:00Z",
+					"updated_at": "2025-01-01T00:00:00Z",
+				},
+				{
+					"id":         "0b2cbc2e-7d3f-4c4b-9d1f-1f4ef7b9a003",
+					"algorithm":  "HS256",
+					"status":     "previously_used",
+					"created_at": "2024-01-01T00:00:00Z",
+					"updated_at": "2025-01-01T00:00:00Z",
+				},
+			},
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: examples.JwksDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_jwks.production", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.supabase_jwks.production", "keys.0.status", "in_use"),
+					resource.TestCheckNoResourceAttr("data.supabase_jwks.production", "keys.2.public_jwk"),
+					resource.TestCheckResourceAttr("data.supabase_jwks.production", "jwks", `{"keys":[{"crv":"P-256","kid":"0b2cbc2e-7d3f-4c4b-9d1f-1f4ef7b9a001","kty":"EC"}]}`),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oapi-codegen/nullable"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &JwtSigningKeyResource{}
+var _ resource.ResourceWithImportState = &JwtSigningKeyResource{}
+
+func NewJwtSigningKeyResource() resource.Resource {
+	return &JwtSigningKeyResource{}
+}
+
+// JwtSigningKeyResource defines the resource implementation.
+type JwtSigningKeyResource struct {
+	client *api.ClientWithResponses
+}
+
+// JwtSigningKeyResourceModel describes the resource data model.
+type JwtSigningKeyResourceModel struct {
+	ProjectRef types.String         `tfsdk:"project_ref"`
+	Algorithm  types.String         `tfsdk:"algorithm"`
+	Status     types.String         `tfsdk:"status"`
+	PublicJwk  jsontypes.Normalized `tfsdk:"public_jwk"`
+	CreatedAt  types.String         `tfsdk:"created_at"`
+	UpdatedAt  types.String         `tfsdk:"updated_at"`
+	Id         types.String         `tfsdk:"id"`
+}
+
+func (r *JwtSigningKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_signing_key"
+}
+
+func (r *JwtSigningKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "JWT signing key resource. Keys move through the `standby` → `in_use` → `previously_used` → `revoked` lifecycle by updating `status`.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "Signing algorithm of the key",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.CreateSigningKeyBodyAlgorithmES256),
+						string(api.CreateSigningKeyBodyAlgorithmEdDSA),
+						string(api.CreateSigningKeyBodyAlgorithmHS256),
+						string(api.CreateSigningKeyBodyAlgorithmRS256),
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the key, defaults to `standby`. Set to `in_use` to rotate to this key. When another key is rotated into use, the API moves this key to `previously_used`; that change is not reported as drift while `status` remains `in_use`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(api.UpdateSigningKeyBodyStatusStandby)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.UpdateSigningKeyBodyStatusStandby),
+						string(api.UpdateSigningKeyBodyStatusInUse),
+						string(api.UpdateSigningKeyBodyStatusPreviouslyUsed),
+						string(api.UpdateSigningKeyBodyStatusRevoked),
+					),
+				},
+			},
+			"public_jwk": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
+				MarkdownDescription: "Public key as serialised JWK, empty for symmetric keys",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Signing key identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *JwtSigningKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *JwtSigningKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JwtSigningKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, createSigningKey(ctx, &data, r.client))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created jwt signing key")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JwtSigningKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JwtSigningKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readSigningKey(ctx, &data, r.client)
+	if resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, diags)...); resp.Diagnostics.HasError() {
+		return
+	}
+	// Key was deleted outside of Terraform
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JwtSigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JwtSigningKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, updateSigningKeyStatus(ctx, &data, r.client))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JwtSigningKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data JwtSigningKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteSigningKey(ctx, &data, r.client)...)
+}
+
+func (r *JwtSigningKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			`Expected import identifier in the format "project_ref/signing_key_id".`,
+		)
+		return
+	}
+
+	projectRef := strings.TrimSpace(parts[0])
+	keyID := strings.TrimSpace(parts[1])
+	if _, err := uuid.Parse(keyID); projectRef == "" || err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Both project_ref and a valid signing_key_id must be provided when importing. Example: myprojectref/3603c575-...",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_ref"), types.StringValue(projectRef))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(keyID))...)
+}
+
+func createSigningKey(ctx context.Context, plan *JwtSigningKeyResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	// New keys can only be created as standby or in use, other states are
+	// reached by transitioning the key after creation.
+	status := api.CreateSigningKeyBodyStatus(plan.Status.ValueString())
+	if status != api.CreateSigningKeyBodyStatusInUse {
+		status = api.CreateSigningKeyBodyStatusStandby
+	}
+
+	httpResp, err := client.V1CreateProjectSigningKeyWithResponse(ctx, plan.ProjectRef.ValueString(), api.CreateSigningKeyBody{
+		Algorithm: api.CreateSigningKeyBodyAlgorithm(plan.Algorithm.ValueString()),
+		Status:    &status,
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to create signing key, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON201 == nil {
+		msg := fmt.Sprintf("Unable to create signing key, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	desired := plan.Status
+	if diags := setSigningKey(plan, httpResp.JSON201); diags.HasError() {
+		return diags
+	}
+	if !plan.Status.Equal(desired) {
+		plan.Status = desired
+		return updateSigningKeyStatus(ctx, plan, client)
+	}
+	return nil
+}
+
+func readSigningKey(ctx context.Context, state *JwtSigningKeyResourceModel, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+	keyID, err := uuid.Parse(state.Id.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to parse signing key identifier, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	httpResp, err := client.V1GetProjectSigningKeyWithResponse(ctx, state.ProjectRef.ValueString(), keyID)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read signing key, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read signing key, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	// Rotating another key into use moves this key out of use on the server,
+	// which is the expected outcome of the rotation rather than drift.
+	superseded := state.Status.ValueString() == string(api.SigningKeyResponseStatusInUse) &&
+		httpResp.JSON200.Status == api.SigningKeyResponseStatusPreviouslyUsed
+	diags := setSigningKey(state, httpResp.JSON200)
+	if superseded {
+		state.Status = types.StringValue(string(api.SigningKeyResponseStatusInUse))
+	}
+	return true, diags
+}
+
+func updateSigningKeyStatus(ctx context.Context, plan *JwtSigningKeyResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	keyID, err := uuid.Parse(plan.Id.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to parse signing key identifier, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	httpResp, err := client.V1UpdateProjectSigningKeyWithResponse(ctx, plan.ProjectRef.ValueString(), keyID, api.UpdateSigningKeyBody{
+		Status: api.UpdateSigningKeyBodyStatus(plan.Status.ValueString()),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to update signing key, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to update signing key, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return setSigningKey(plan, httpResp.JSON200)
+}
+
+func deleteSigningKey(ctx context.Context, state *JwtSigningKeyResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	keyID, err := uuid.Parse(state.Id.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to parse signing key identifier, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	httpResp, err := client.V1RemoveProjectSigningKeyWithResponse(ctx, state.ProjectRef.ValueString(), keyID)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to delete signing key, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	if httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to delete signing key, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return nil
+}
+
+func setSigningKey(data *JwtSigningKeyResourceModel, key *api.SigningKeyResponse) diag.Diagnostics {
+	publicJwk, err := parsePublicJwk(key.PublicJwk)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to parse public jwk, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	data.Id = types.StringValue(key.Id.String())
+	data.Algorithm = types.StringValue(string(key.Algorithm))
+	data.Status = types.StringValue(string(key.Status))
+	data.PublicJwk = publicJwk
+	data.CreatedAt = types.StringValue(key.CreatedAt.Format(time.RFC3339))
+	data.UpdatedAt = types.StringValue(key.UpdatedAt.Format(time.RFC3339))
+	return nil
+}
+
+func parsePublicJwk(jwk nullable.Nullable[interface{}]) (jsontypes.Normalized, error) {
+	if !jwk.IsSpecified() || jwk.IsNull() {
+		return jsontypes.NewNormalizedNull(), nil
+	}
+	value, err := json.Marshal(jwk.MustGet())
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), err
+	}
+	return jsontypes.NewNormalizedValue(string(value)), nil
+}
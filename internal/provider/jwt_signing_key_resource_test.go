@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/oapi-codegen/nullable"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccJwtSigningKeyResource(t *testing.T) {
+	testKeyUUID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	publicJwk := nullable.NewNullableWithValue[interface{}](map[string]any{
+		"kty": "EC",
+		"crv": "P-256",
+		"alg": "ES256",
+		"kid": testKeyUUID.String(),
+		"x":   "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU",
+		"y":   "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0",
+	})
+	standbyKey := api.SigningKeyResponse{
+		Id:        testKeyUUID,
+		Algorithm: api.SigningKeyResponseAlgorithmES256,
+		Status:    api.SigningKeyResponseStatusStandby,
+		PublicJwk: publicJwk,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	inUseKey := standbyKey
+	inUseKey.Status = api.SigningKeyResponseStatusInUse
+	inUseKey.UpdatedAt = createdAt.Add(time.Hour)
+	supersededKey := inUseKey
+	supersededKey.Status = api.SigningKeyResponseStatusPreviouslyUsed
+	supersededKey.UpdatedAt = createdAt.Add(2 * time.Hour)
+	testKeyEndpoint := fmt.Sprintf("/v1/projects/mayuaycdtijbctgqbycg/config/auth/signing-keys/%s", testKeyUUID)
+	superseded, deleted := false, false
+	// Setup mock api
+	defer gock.OffAll()
+	// Step 5: deleted outside of Terraform
+	gock.New("https://api.supabase.com").
+		Get(testKeyEndpoint).
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return deleted, nil }).
+		Persist().
+		Reply(http.StatusNotFound)
+	// Step 4: another key rotated into use
+	gock.New("https://api.supabase.com").
+		Get(testKeyEndpoint).
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return superseded, nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(supersededKey)
+	// Step 1: create
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/config/auth/signing-keys").
+		Reply(http.StatusCreated).
+		JSON(standbyKey)
+	gock.New("https://api.supabase.com").
+		Get(testKeyEndpoint).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(standbyKey)
+	// Step 2: rotate to in use
+	gock.New("https://api.supabase.com").
+		Patch(testKeyEndpoint).
+		JSON(api.UpdateSigningKeyBody{Status: api.UpdateSigningKeyBodyStatusInUse}).
+		Reply(http.StatusOK).
+		JSON(inUseKey)
+	gock.New("https://api.supabase.com").
+		Get(testKeyEndpoint).
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(inUseKey)
+	// Step 4: delete
+	gock.New("https://api.supabase.com").
+		Delete(testKeyEndpoint).
+		Reply(http.StatusOK).
+		JSON(inUseKey)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.JwtSigningKeyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.standby", "id", testKeyUUID.String()),
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.standby", "status", "standby"),
+					resource.TestCheckResourceAttrSet("supabase_jwt_signing_key.standby", "public_jwk"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: strings.ReplaceAll(examples.JwtSigningKeyResourceConfig, `"standby"`, `"in_use"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.standby", "status", "in_use"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_jwt_signing_key.standby",
+				ImportState:       true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) { return "mayuaycdtijbctgqbycg/" + testKeyUUID.String(), nil },
+				ImportStateVerify: true,
+			},
+			// Moving out of use after another key is rotated in is not drift
+			{
+				PreConfig: func() { superseded = true },
+				Config:    strings.ReplaceAll(examples.JwtSigningKeyResourceConfig, `"standby"`, `"in_use"`),
+				PlanOnly:  true,
+			},
+			// Keys deleted outside of Terraform are recreated
+			{
+				PreConfig:          func() { deleted = true },
+				Config:             strings.ReplaceAll(examples.JwtSigningKeyResourceConfig, `"standby"`, `"in_use"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("supabase_jwt_signing_key.standby", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccLegacyApiKeysResource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	// Step 1: create
+	gock.New("https://api.supabase.com").
+		Put("/v1/projects/mayuaycdtijbctgqbycg/api-keys/legacy").
+		MatchParam("enabled", "false").
+		Reply(http.StatusOK).
+		JSON(api.LegacyApiKeysResponse{Enabled: false})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/api-keys/legacy").
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(api.LegacyApiKeysResponse{Enabled: false})
+	// Step 2: update
+	gock.New("https://api.supabase.com").
+		Put("/v1/projects/mayuaycdtijbctgqbycg/api-keys/legacy").
+		MatchParam("enabled", "true").
+		Reply(http.StatusOK).
+		JSON(api.LegacyApiKeysResponse{Enabled: true})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/api-keys/legacy").
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(api.LegacyApiKeysResponse{Enabled: true})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.LegacyApiKeysResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_legacy_api_keys.production", "id", "mayuaycdtijbctgqbycg"),
+					resource.TestCheckResourceAttr("supabase_legacy_api_keys.production", "enabled", "false"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: strings.ReplaceAll(examples.LegacyApiKeysResourceConfig, "false", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_legacy_api_keys.production", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_legacy_api_keys.production",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
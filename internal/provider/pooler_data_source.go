@@ -9,7 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/supabase/cli/pkg/api"
@@ -30,7 +30,23 @@ type PoolerDataSource struct {
 // PoolerDataSourceModel describes the data source data model.
 type PoolerDataSourceModel struct {
 	ProjectRef types.String  `tfsdk:"project_ref"`
-	Url        types.MapType `tfsdk:"url"`
+	Url        types.Map     `tfsdk:"url"`
+	Poolers    []PoolerModel `tfsdk:"poolers"`
+	Ipv4Addon  types.Bool    `tfsdk:"ipv4_addon"`
+}
+
+// PoolerModel describes a single Supavisor pooler of the project.
+type PoolerModel struct {
+	DatabaseType     types.String `tfsdk:"database_type"`
+	Identifier       types.String `tfsdk:"identifier"`
+	Host             types.String `tfsdk:"host"`
+	Port             types.Int64  `tfsdk:"port"`
+	User             types.String `tfsdk:"user"`
+	DbName           types.String `tfsdk:"dbname"`
+	PoolMode         types.String `tfsdk:"pool_mode"`
+	DefaultPoolSize  types.Int64  `tfsdk:"default_pool_size"`
+	MaxClientConn    types.Int64  `tfsdk:"max_client_conn"`
+	ConnectionString types.String `tfsdk:"connection_string"`
 }
 
 func (d *PoolerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,10 +64,62 @@ func (d *PoolerDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Required:            true,
 			},
 			"url": schema.MapAttribute{
-				MarkdownDescription: "Map of pooler mode to connection string",
+				MarkdownDescription: "Map of pooler mode to connection string of the primary database",
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"poolers": schema.ListNestedAttribute{
+				MarkdownDescription: "All poolers of the project, including those of read replicas. Poolers are always reachable over IPv4.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database_type": schema.StringAttribute{
+							MarkdownDescription: "Database type, either `PRIMARY` or `READ_REPLICA`",
+							Computed:            true,
+						},
+						"identifier": schema.StringAttribute{
+							MarkdownDescription: "Database identifier",
+							Computed:            true,
+						},
+						"host": schema.StringAttribute{
+							MarkdownDescription: "Pooler host",
+							Computed:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Pooler port",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							MarkdownDescription: "Database user",
+							Computed:            true,
+						},
+						"dbname": schema.StringAttribute{
+							MarkdownDescription: "Database name",
+							Computed:            true,
+						},
+						"pool_mode": schema.StringAttribute{
+							MarkdownDescription: "Pool mode, either `session` or `transaction`",
+							Computed:            true,
+						},
+						"default_pool_size": schema.Int64Attribute{
+							MarkdownDescription: "Default pool size",
+							Computed:            true,
+						},
+						"max_client_conn": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of client connections",
+							Computed:            true,
+						},
+						"connection_string": schema.StringAttribute{
+							MarkdownDescription: "Connection string with a password placeholder",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ipv4_addon": schema.BoolAttribute{
+				MarkdownDescription: "Whether the IPv4 add-on is enabled, which makes direct connections to the database reachable over IPv4. Without it, IPv4 only clients must connect through a pooler.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -77,17 +145,17 @@ func (d *PoolerDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *PoolerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var projectRef types.String
+	var data PoolerDataSourceModel
 
 	// Read Terraform configuration data into the model
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_ref"), &projectRef)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
-	httpResp, err := d.client.V1GetPoolerConfigWithResponse(ctx, projectRef.ValueString())
+	httpResp, err := d.client.V1GetPoolerConfigWithResponse(ctx, data.ProjectRef.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read pooler, got error: %s", err)
 		resp.Diagnostics.AddError("Client Error", msg)
@@ -100,16 +168,40 @@ func (d *PoolerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	url := map[string]string{}
+	data.Poolers = make([]PoolerModel, 0, len(*httpResp.JSON200))
 	for _, pooler := range *httpResp.JSON200 {
 		if pooler.DatabaseType == api.SupavisorConfigResponseDatabaseTypePRIMARY {
 			url[string(pooler.PoolMode)] = pooler.ConnectionString
 		}
+		data.Poolers = append(data.Poolers, PoolerModel{
+			DatabaseType:     types.StringValue(string(pooler.DatabaseType)),
+			Identifier:       types.StringValue(pooler.Identifier),
+			Host:             types.StringValue(pooler.DbHost),
+			Port:             types.Int64Value(int64(pooler.DbPort)),
+			User:             types.StringValue(pooler.DbUser),
+			DbName:           types.StringValue(pooler.DbName),
+			PoolMode:         types.StringValue(string(pooler.PoolMode)),
+			DefaultPoolSize:  NullableToInt64(pooler.DefaultPoolSize),
+			MaxClientConn:    NullableToInt64(pooler.MaxClientConn),
+			ConnectionString: types.StringValue(pooler.ConnectionString),
+		})
+	}
+	var diags diag.Diagnostics
+	data.Url, diags = types.MapValueFrom(ctx, types.StringType, url)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipv4, diags := projectHasIpv4(ctx, data.ProjectRef.ValueString(), d.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
 	}
+	data.Ipv4Addon = types.BoolValue(ipv4)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "read a data source")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), url)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectAddonResource{}
+var _ resource.ResourceWithImportState = &ProjectAddonResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectAddonResource{}
+
+// projectAddonVariants lists the variants accepted for each add-on type
+// managed by this resource. Compute size is managed by supabase_project.
+var projectAddonVariants = map[string][]string{
+	string(api.ApplyProjectAddonBodyAddonTypeIpv4): {
+		string(api.ApplyProjectAddonBodyAddonVariant3Ipv4Default),
+	},
+	string(api.ApplyProjectAddonBodyAddonTypePitr): {
+		string(api.ApplyProjectAddonBodyAddonVariant2Pitr7),
+		string(api.ApplyProjectAddonBodyAddonVariant2Pitr14),
+		string(api.ApplyProjectAddonBodyAddonVariant2Pitr28),
+	},
+	string(api.ApplyProjectAddonBodyAddonTypeCustomDomain): {
+		string(api.ApplyProjectAddonBodyAddonVariant1CdDefault),
+	},
+}
+
+func NewProjectAddonResource() resource.Resource {
+	return &ProjectAddonResource{}
+}
+
+// ProjectAddonResource defines the resource implementation.
+type ProjectAddonResource struct {
+	client *api.ClientWithResponses
+}
+
+// ProjectAddonResourceModel describes the resource data model.
+type ProjectAddonResourceModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+	AddonType  types.String `tfsdk:"addon_type"`
+	Variant    types.String `tfsdk:"variant"`
+	Name       types.String `tfsdk:"name"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func (r *ProjectAddonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_addon"
+}
+
+func (r *ProjectAddonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project add-on resource. Applies an IPv4, point-in-time recovery or custom domain add-on to a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"addon_type": schema.StringAttribute{
+				MarkdownDescription: "Add-on type, one of `ipv4`, `pitr` or `custom_domain`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.ApplyProjectAddonBodyAddonTypeIpv4),
+						string(api.ApplyProjectAddonBodyAddonTypePitr),
+						string(api.ApplyProjectAddonBodyAddonTypeCustomDomain),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Add-on variant, such as `ipv4_default`, `pitr_7` or `cd_default`",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the selected variant",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Add-on identifier in the format `project_ref/addon_type`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectAddonResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProjectAddonResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AddonType.IsUnknown() || data.AddonType.IsNull() || data.Variant.IsUnknown() || data.Variant.IsNull() {
+		return
+	}
+
+	variants, ok := projectAddonVariants[data.AddonType.ValueString()]
+	if ok && !slices.Contains(variants, data.Variant.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("variant"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Variant %q is not available for add-on type %q, expected one of: %s",
+				data.Variant.ValueString(), data.AddonType.ValueString(), strings.Join(variants, ", ")),
+		)
+	}
+}
+
+func (r *ProjectAddonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectAddonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectAddonResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(applyProjectAddon(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.ProjectRef.ValueString(), data.AddonType.ValueString()))
+	resp.Diagnostics.Append(readProjectAddon(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAddonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectAddonResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readProjectAddon(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add-on was removed outside of terraform
+	if data.Variant.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAddonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectAddonResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(applyProjectAddon(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readProjectAddon(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAddonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectAddonResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(removeProjectAddon(ctx, &data, r.client)...)
+}
+
+func (r *ProjectAddonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			`Expected import identifier in the format "project_ref/addon_type".`,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_ref"), types.StringValue(parts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("addon_type"), types.StringValue(parts[1]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+}
+
+func listProjectAddons(ctx context.Context, projectRef string, client *api.ClientWithResponses) (*api.ListProjectAddonsResponse, diag.Diagnostics) {
+	httpResp, err := client.V1ListProjectAddonsWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read project addons, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read project addons, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return httpResp.JSON200, nil
+}
+
+func readProjectAddon(ctx context.Context, state *ProjectAddonResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	addons, diags := listProjectAddons(ctx, state.ProjectRef.ValueString(), client)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.Variant = types.StringNull()
+	state.Name = types.StringNull()
+	for _, addon := range addons.SelectedAddons {
+		if string(addon.Type) != state.AddonType.ValueString() {
+			continue
+		}
+		// All variant ids are plain strings, so any of the union members decodes them.
+		id, err := addon.Variant.Id.AsListProjectAddonsResponseSelectedAddonsVariantId0()
+		if err != nil {
+			msg := fmt.Sprintf("Unable to read %s addon, got error: %s", state.AddonType.ValueString(), err)
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		state.Variant = types.StringValue(string(id))
+		state.Name = types.StringValue(addon.Variant.Name)
+		break
+	}
+
+	return nil
+}
+
+func applyProjectAddon(ctx context.Context, plan *ProjectAddonResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	addon := api.ApplyProjectAddonBody_AddonVariant{}
+	var err error
+	switch variant := plan.Variant.ValueString(); plan.AddonType.ValueString() {
+	case string(api.ApplyProjectAddonBodyAddonTypeCustomDomain):
+		err = addon.FromApplyProjectAddonBodyAddonVariant1(api.ApplyProjectAddonBodyAddonVariant1(variant))
+	case string(api.ApplyProjectAddonBodyAddonTypePitr):
+		err = addon.FromApplyProjectAddonBodyAddonVariant2(api.ApplyProjectAddonBodyAddonVariant2(variant))
+	default:
+		err = addon.FromApplyProjectAddonBodyAddonVariant3(api.ApplyProjectAddonBodyAddonVariant3(variant))
+	}
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Internal Error",
+			fmt.Sprintf("Failed to configure addon variant: %s", err),
+		)}
+	}
+	body := api.V1ApplyProjectAddonJSONRequestBody{
+		AddonType:    api.ApplyProjectAddonBodyAddonType(plan.AddonType.ValueString()),
+		AddonVariant: addon,
+	}
+
+	httpResp, err := client.V1ApplyProjectAddonWithResponse(ctx, plan.ProjectRef.ValueString(), body)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to apply project addon, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to apply project addon, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return nil
+}
+
+func removeProjectAddon(ctx context.Context, state *ProjectAddonResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	// An addon removed outside of Terraform has no variant left to remove.
+	if state.Variant.IsNull() || state.Variant.IsUnknown() || state.Variant.ValueString() == "" {
+		tflog.Trace(ctx, fmt.Sprintf("project addon has no variant: %s", state.Id.ValueString()))
+		return nil
+	}
+
+	// The generated client takes the variant as an unexported union type, so the
+	// request is built by hand using the same server, doer and request editors.
+	raw, ok := client.ClientInterface.(*api.Client)
+	if !ok {
+		msg := fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", client.ClientInterface)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Internal Error", msg)}
+	}
+
+	operationPath := fmt.Sprintf("./v1/projects/%s/billing/addons/%s",
+		url.PathEscape(state.ProjectRef.ValueString()),
+		url.PathEscape(state.Variant.ValueString()),
+	)
+	serverURL, err := url.Parse(raw.Server)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Internal Error", err.Error())}
+	}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Internal Error", err.Error())}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Internal Error", err.Error())}
+	}
+	for _, edit := range raw.RequestEditors {
+		if err := edit(ctx, req); err != nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Internal Error", err.Error())}
+		}
+	}
+
+	httpResp, err := raw.Client.Do(req)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to remove project addon, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	defer httpResp.Body.Close()
+
+	// Deleted project is an orphan resource, not returning error so it can be destroyed.
+	if httpResp.StatusCode == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("project addon not found: %s", state.Id.ValueString()))
+		return nil
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		msg := fmt.Sprintf("Unable to remove project addon, got status %d", httpResp.StatusCode)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return nil
+}
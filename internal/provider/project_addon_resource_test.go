@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccProjectAddonResource(t *testing.T) {
+	updated := false
+	// Setup mock api
+	defer gock.OffAll()
+	// Step 1: create
+	gock.New("https://api.supabase.com").
+		Patch("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		AddMatcher(testAccBodyContains(`"addon_type":"pitr"`, `"addon_variant":"pitr_7"`)).
+		Reply(http.StatusOK)
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return !updated, nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectAddons("pitr_7", "7 days"))
+	// Step 2: update
+	gock.New("https://api.supabase.com").
+		Patch("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		AddMatcher(testAccBodyContains(`"addon_type":"pitr"`, `"addon_variant":"pitr_14"`)).
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) {
+			updated = true
+			return true, nil
+		}).
+		Reply(http.StatusOK)
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return updated, nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectAddons("pitr_14", "14 days"))
+	// Step 4: delete
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg/billing/addons/pitr_14$").
+		Reply(http.StatusOK)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.ProjectAddonResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project_addon.pitr", "id", "mayuaycdtijbctgqbycg/pitr"),
+					resource.TestCheckResourceAttr("supabase_project_addon.pitr", "variant", "pitr_7"),
+					resource.TestCheckResourceAttr("supabase_project_addon.pitr", "name", "7 days"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: strings.ReplaceAll(examples.ProjectAddonResourceConfig, "pitr_7", "pitr_14"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project_addon.pitr", "variant", "pitr_14"),
+					resource.TestCheckResourceAttr("supabase_project_addon.pitr", "name", "14 days"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_project_addon.pitr",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectAddonResourceInvalidVariant(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.ReplaceAll(examples.ProjectAddonResourceConfig, "pitr_7", "ipv4_default"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Variant "ipv4_default" is not available for add-on type "pitr"`),
+			},
+		},
+	})
+}
+
+func testAccProjectAddons(pitrVariant, pitrName string) map[string]any {
+	return map[string]any{
+		"selected_addons": []map[string]any{{
+			"type": "pitr",
+			"variant": map[string]any{
+				"id":    pitrVariant,
+				"name":  pitrName,
+				"price": map[string]any{"amount": 100, "description": "$100/month", "interval": "monthly", "type": "fixed"},
+			},
+		}},
+		"available_addons": []map[string]any{{
+			"name": "Point in time recovery",
+			"type": "pitr",
+			"variants": []map[string]any{{
+				"id":    "pitr_7",
+				"name":  "7 days",
+				"price": map[string]any{"amount": 100, "description": "$100/month", "interval": "monthly", "type": "fixed"},
+			}, {
+				"id":    "pitr_14",
+				"name":  "14 days",
+				"price": map[string]any{"amount": 200, "description": "$200/month", "interval": "monthly", "type": "fixed"},
+			}},
+		}, {
+			"name": "Dedicated IPv4 address",
+			"type": "ipv4",
+			"variants": []map[string]any{{
+				"id":    "ipv4_default",
+				"name":  "Dedicated IPv4 address",
+				"price": map[string]any{"amount": 0.0055, "description": "$0.0055/hour", "interval": "hourly", "type": "usage"},
+			}},
+		}},
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectAddonVariantsDataSource{}
+
+func NewProjectAddonVariantsDataSource() datasource.DataSource {
+	return &ProjectAddonVariantsDataSource{}
+}
+
+// ProjectAddonVariantsDataSource defines the data source implementation.
+type ProjectAddonVariantsDataSource struct {
+	client *api.ClientWithResponses
+}
+
+// ProjectAddonVariantsDataSourceModel describes the data source data model.
+type ProjectAddonVariantsDataSourceModel struct {
+	ProjectRef types.String        `tfsdk:"project_ref"`
+	AddonType  types.String        `tfsdk:"addon_type"`
+	Variants   []AddonVariantModel `tfsdk:"variants"`
+}
+
+// AddonVariantModel describes a single add-on variant available to the project.
+type AddonVariantModel struct {
+	AddonType        types.String  `tfsdk:"addon_type"`
+	AddonName        types.String  `tfsdk:"addon_name"`
+	Id               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	PriceAmount      types.Float64 `tfsdk:"price_amount"`
+	PriceDescription types.String  `tfsdk:"price_description"`
+	PriceInterval    types.String  `tfsdk:"price_interval"`
+	PriceType        types.String  `tfsdk:"price_type"`
+	Selected         types.Bool    `tfsdk:"selected"`
+}
+
+func (d *ProjectAddonVariantsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_addon_variants"
+}
+
+func (d *ProjectAddonVariantsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Project add-on variants data source",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project ref",
+				Required:            true,
+			},
+			"addon_type": schema.StringAttribute{
+				MarkdownDescription: "Only list variants of this add-on type, such as `ipv4`, `pitr` or `custom_domain`",
+				Optional:            true,
+			},
+			"variants": schema.ListNestedAttribute{
+				MarkdownDescription: "Add-on variants available to the project",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"addon_type": schema.StringAttribute{
+							MarkdownDescription: "Add-on type",
+							Computed:            true,
+						},
+						"addon_name": schema.StringAttribute{
+							MarkdownDescription: "Add-on display name",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Variant identifier, usable as `variant` of `supabase_project_addon`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Variant display name",
+							Computed:            true,
+						},
+						"price_amount": schema.Float64Attribute{
+							MarkdownDescription: "Price amount in USD",
+							Computed:            true,
+						},
+						"price_description": schema.StringAttribute{
+							MarkdownDescription: "Price description",
+							Computed:            true,
+						},
+						"price_interval": schema.StringAttribute{
+							MarkdownDescription: "Billing interval, either `monthly` or `hourly`",
+							Computed:            true,
+						},
+						"price_type": schema.StringAttribute{
+							MarkdownDescription: "Price type, either `fixed` or `usage`",
+							Computed:            true,
+						},
+						"selected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the variant is currently applied to the project",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectAddonVariantsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectAddonVariantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectAddonVariantsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addons, diags := listProjectAddons(ctx, data.ProjectRef.ValueString(), d.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	selected := map[string]string{}
+	for _, addon := range addons.SelectedAddons {
+		id, err := addon.Variant.Id.AsListProjectAddonsResponseSelectedAddonsVariantId0()
+		if err != nil {
+			msg := fmt.Sprintf("Unable to read %s addon, got error: %s", addon.Type, err)
+			resp.Diagnostics.AddError("Client Error", msg)
+			return
+		}
+		selected[string(addon.Type)] = string(id)
+	}
+
+	data.Variants = []AddonVariantModel{}
+	for _, addon := range addons.AvailableAddons {
+		if !data.AddonType.IsNull() && string(addon.Type) != data.AddonType.ValueString() {
+			continue
+		}
+		for _, variant := range addon.Variants {
+			id, err := variant.Id.AsListProjectAddonsResponseAvailableAddonsVariantsId0()
+			if err != nil {
+				msg := fmt.Sprintf("Unable to read %s addon variants, got error: %s", addon.Type, err)
+				resp.Diagnostics.AddError("Client Error", msg)
+				return
+			}
+			data.Variants = append(data.Variants, AddonVariantModel{
+				AddonType:        types.StringValue(string(addon.Type)),
+				AddonName:        types.StringValue(addon.Name),
+				Id:               types.StringValue(string(id)),
+				Name:             types.StringValue(variant.Name),
+				PriceAmount:      types.Float64Value(float64(variant.Price.Amount)),
+				PriceDescription: types.StringValue(variant.Price.Description),
+				PriceInterval:    types.StringValue(string(variant.Price.Interval)),
+				PriceType:        types.StringValue(string(variant.Price.Type)),
+				Selected:         types.BoolValue(selected[string(addon.Type)] == string(id)),
+			})
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
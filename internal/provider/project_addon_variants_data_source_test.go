@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccProjectAddonVariantsDataSource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(testAccProjectAddons("pitr_7", "7 days"))
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: examples.ProjectAddonVariantsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_project_addon_variants.pitr", "variants.#", "2"),
+					resource.TestCheckResourceAttr("data.supabase_project_addon_variants.pitr", "variants.0.id", "pitr_7"),
+					resource.TestCheckResourceAttr("data.supabase_project_addon_variants.pitr", "variants.0.selected", "true"),
+					resource.TestCheckResourceAttr("data.supabase_project_addon_variants.pitr", "variants.1.id", "pitr_14"),
+					resource.TestCheckResourceAttr("data.supabase_project_addon_variants.pitr", "variants.1.addon_name", "Point in time recovery"),
+					resource.TestCheckResourceAttr("data.supabase_project_addon_variants.pitr", "variants.1.price_amount", "200"),
+					resource.TestCheckResourceAttr("data.supabase_project_addon_variants.pitr", "variants.1.price_interval", "monthly"),
+					resource.TestCheckResourceAttr("data.supabase_project_addon_variants.pitr", "variants.1.selected", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+// ProjectDataSource defines the data source implementation.
+type ProjectDataSource struct {
+	client *api.ClientWithResponses
+}
+
+// ProjectDataSourceModel describes the data source data model.
+type ProjectDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	Name            types.String `tfsdk:"name"`
+	Region          types.String `tfsdk:"region"`
+	Status          types.String `tfsdk:"status"`
+	PostgresVersion types.String `tfsdk:"postgres_version"`
+	PostgresEngine  types.String `tfsdk:"postgres_engine"`
+	ReleaseChannel  types.String `tfsdk:"release_channel"`
+	DatabaseHost    types.String `tfsdk:"database_host"`
+	ApiUrl          types.String `tfsdk:"api_url"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := projectDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Project identifier to look up",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["organization_id"] = schema.StringAttribute{
+		MarkdownDescription: "Organization slug, required when looking up a project by name",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the project to look up",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("organization_id")),
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project data source. Looks up a project by its identifier, or by organization and name.",
+		Attributes:          attributes,
+	}
+}
+
+// projectDataSourceAttributes returns the computed attributes of a project, shared
+// with the projects data source.
+func projectDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Project identifier",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "Organization slug",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the project",
+			Computed:            true,
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Region where the project is located",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the project, e.g. `ACTIVE_HEALTHY` or `INACTIVE`",
+			Computed:            true,
+		},
+		"postgres_version": schema.StringAttribute{
+			MarkdownDescription: "Postgres version of the project database",
+			Computed:            true,
+		},
+		"postgres_engine": schema.StringAttribute{
+			MarkdownDescription: "Postgres engine of the project database",
+			Computed:            true,
+		},
+		"release_channel": schema.StringAttribute{
+			MarkdownDescription: "Release channel of the project database",
+			Computed:            true,
+		},
+		"database_host": schema.StringAttribute{
+			MarkdownDescription: "Host of the project database",
+			Computed:            true,
+		},
+		"api_url": schema.StringAttribute{
+			MarkdownDescription: "URL of the project API",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Creation timestamp",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *ProjectDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Id.IsNull() {
+		httpResp, err := d.client.V1GetProjectWithResponse(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			return
+		}
+		if httpResp.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.AddError("Project Not Found", fmt.Sprintf("Project %s does not exist", data.Id.ValueString()))
+			return
+		}
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+		data = projectDataSourceModel(httpResp.JSON200)
+	} else {
+		projects, diags := listProjects(ctx, d.client)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		var matches []api.V1ProjectWithDatabaseResponse
+		for _, project := range projects {
+			if project.Name == data.Name.ValueString() && inOrganization(project, data.OrganizationId.ValueString()) {
+				matches = append(matches, project)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError("Project Not Found", fmt.Sprintf("No project named %s in organization %s", data.Name.ValueString(), data.OrganizationId.ValueString()))
+			return
+		case 1:
+			data = projectDataSourceModel(&matches[0])
+		default:
+			resp.Diagnostics.AddError("Ambiguous Project", fmt.Sprintf("Multiple projects named %s in organization %s, look up the project by id instead", data.Name.ValueString(), data.OrganizationId.ValueString()))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "read project data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func projectDataSourceModel(project *api.V1ProjectWithDatabaseResponse) ProjectDataSourceModel {
+	return ProjectDataSourceModel{
+		Id:              types.StringValue(project.Ref),
+		OrganizationId:  types.StringValue(organizationSlug(*project)),
+		Name:            types.StringValue(project.Name),
+		Region:          types.StringValue(project.Region),
+		Status:          types.StringValue(string(project.Status)),
+		PostgresVersion: types.StringValue(project.Database.Version),
+		PostgresEngine:  types.StringValue(project.Database.PostgresEngine),
+		ReleaseChannel:  types.StringValue(project.Database.ReleaseChannel),
+		DatabaseHost:    types.StringValue(project.Database.Host),
+		ApiUrl:          types.StringValue(projectApiUrl(project.Ref)),
+		CreatedAt:       types.StringValue(project.CreatedAt),
+	}
+}
+
+func projectApiUrl(ref string) string {
+	return fmt.Sprintf("https://%s.supabase.co", ref)
+}
+
+// organizationSlug returns the organization slug, falling back to the deprecated identifier.
+func organizationSlug(project api.V1ProjectWithDatabaseResponse) string {
+	if len(project.OrganizationSlug) > 0 {
+		return project.OrganizationSlug
+	}
+	return project.OrganizationId
+}
+
+// inOrganization matches the organization by slug, falling back to the deprecated identifier.
+func inOrganization(project api.V1ProjectWithDatabaseResponse, organization string) bool {
+	return project.OrganizationSlug == organization || project.OrganizationId == organization
+}
+
+func listProjects(ctx context.Context, client *api.ClientWithResponses) ([]api.V1ProjectWithDatabaseResponse, diag.Diagnostics) {
+	httpResp, err := client.V1ListAllProjectsWithResponse(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list projects, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list projects, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return *httpResp.JSON200, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var testAccProjects = []map[string]any{{
+	"id":                "mayuaycdtijbctgqbycg",
+	"ref":               "mayuaycdtijbctgqbycg",
+	"organization_id":   "continued-brown-smelt",
+	"organization_slug": "continued-brown-smelt",
+	"name":              "foo",
+	"region":            "us-east-1",
+	"status":            "ACTIVE_HEALTHY",
+	"created_at":        "2025-01-01T00:00:00Z",
+	"database": map[string]any{
+		"host":            "db.mayuaycdtijbctgqbycg.supabase.co",
+		"version":         "15.8.1.085",
+		"postgres_engine": "15",
+		"release_channel": "ga",
+	},
+}, {
+	"id":                "kwyqzbpyvdkhjwwkdakg",
+	"ref":               "kwyqzbpyvdkhjwwkdakg",
+	"organization_id":   "continued-brown-smelt",
+	"organization_slug": "continued-brown-smelt",
+	"name":              "staging-bar",
+	"region":            "eu-west-1",
+	"status":            "INACTIVE",
+	"created_at":        "2025-02-01T00:00:00Z",
+	"database": map[string]any{
+		"host":            "db.kwyqzbpyvdkhjwwkdakg.supabase.co",
+		"version":         "17.4.1.054",
+		"postgres_engine": "17",
+		"release_channel": "ga",
+	},
+}}
+
+func TestAccProjectDataSource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjects)
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/kwyqzbpyvdkhjwwkdakg").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjects[1])
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by name testing
+			{
+				Config: examples.ProjectDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_project.production", "id", "mayuaycdtijbctgqbycg"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "status", "ACTIVE_HEALTHY"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "postgres_version", "15.8.1.085"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "database_host", "db.mayuaycdtijbctgqbycg.supabase.co"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "api_url", "https://mayuaycdtijbctgqbycg.supabase.co"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "created_at", "2025-01-01T00:00:00Z"),
+				),
+			},
+			// Read by id testing
+			{
+				Config: testAccProjectDataSourceByIdConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_project.staging", "name", "staging-bar"),
+					resource.TestCheckResourceAttr("data.supabase_project.staging", "organization_id", "continued-brown-smelt"),
+					resource.TestCheckResourceAttr("data.supabase_project.staging", "postgres_engine", "17"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProjectDataSourceByIdConfig = `
+data "supabase_project" "staging" {
+  id = "kwyqzbpyvdkhjwwkdakg"
+}
+`
@@ -8,12 +8,18 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -25,6 +31,15 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithConfigValidators = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectResource{}
+
+const (
+	projectUpgradeTimeout  = 4 * time.Hour
+	projectStatusTimeout   = 30 * time.Minute
+	databasePasswordLength = 32
+)
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -37,12 +52,39 @@ type ProjectResource struct {
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	OrganizationId   types.String `tfsdk:"organization_id"`
-	Name             types.String `tfsdk:"name"`
-	DatabasePassword types.String `tfsdk:"database_password"`
-	Region           types.String `tfsdk:"region"`
-	InstanceSize     types.String `tfsdk:"instance_size"`
-	Id               types.String `tfsdk:"id"`
+	OrganizationId            types.String `tfsdk:"organization_id"`
+	Name                      types.String `tfsdk:"name"`
+	DatabasePassword          types.String `tfsdk:"database_password"`
+	DatabasePasswordWo        types.String `tfsdk:"database_password_wo"`
+	DatabasePasswordWoVersion types.Int64  `tfsdk:"database_password_wo_version"`
+	GenerateDatabasePassword  types.Bool   `tfsdk:"generate_database_password"`
+	GeneratedDatabasePassword types.String `tfsdk:"generated_database_password"`
+	DatabasePasswordKeepers   types.Map    `tfsdk:"database_password_keepers"`
+	Region                    types.String `tfsdk:"region"`
+	RegionGroup               types.String `tfsdk:"region_group"`
+	InstanceSize              types.String `tfsdk:"instance_size"`
+	Paused                    types.Bool   `tfsdk:"paused"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
+	ApiUrl                    types.String `tfsdk:"api_url"`
+	DatabaseHost              types.String `tfsdk:"database_host"`
+	PostgresVersion           types.String `tfsdk:"postgres_version"`
+	PostgresEngine            types.String `tfsdk:"postgres_engine"`
+	ReleaseChannel            types.String `tfsdk:"release_channel"`
+	Status                    types.String `tfsdk:"status"`
+	CreatedAt                 types.String `tfsdk:"created_at"`
+	Id                        types.String `tfsdk:"id"`
+}
+
+// databasePassword returns the write-only password when configured, falling
+// back to the generated password or the password persisted in state.
+func (m ProjectResourceModel) databasePassword() string {
+	if !m.DatabasePasswordWo.IsNull() && !m.DatabasePasswordWo.IsUnknown() {
+		return m.DatabasePasswordWo.ValueString()
+	}
+	if m.GenerateDatabasePassword.ValueBool() {
+		return m.GeneratedDatabasePassword.ValueString()
+	}
+	return m.DatabasePassword.ValueString()
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,16 +106,115 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"database_password": schema.StringAttribute{
 				MarkdownDescription: "Password for the project database",
-				Required:            true,
+				Optional:            true,
+				Sensitive:           true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(4)},
+			},
+			"database_password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only password for the project database. Unlike `database_password`, this value is never stored in state. Requires Terraform 1.11 or later.",
+				Optional:            true,
 				Sensitive:           true,
+				WriteOnly:           true,
 				Validators:          []validator.String{stringvalidator.LengthAtLeast(4)},
 			},
+			"database_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `database_password_wo`. Increment this value to rotate the database password.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("database_password_wo")),
+				},
+			},
+			"generate_database_password": schema.BoolAttribute{
+				MarkdownDescription: "Generate a strong password for the project database instead of setting `database_password`. The password is exposed as `generated_database_password`.",
+				Optional:            true,
+			},
+			"generated_database_password": schema.StringAttribute{
+				MarkdownDescription: "Password generated for the project database when `generate_database_password` is enabled",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_password_keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, rotate the generated database password",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot("generate_database_password")),
+				},
+			},
 			"region": schema.StringAttribute{
-				MarkdownDescription: "Region where the project is located",
-				Required:            true,
+				MarkdownDescription: "Region where the project is located. When `region_group` is set instead, this is the region chosen on creation.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region_group": schema.StringAttribute{
+				MarkdownDescription: "Smart region group to create the project in, e.g. `americas`, `emea` or `apac`. The best available region within the group is chosen on creation. Changing it forces a new project to be created.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						// Imported projects have no region group recorded
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Changing the region group forces a new project to be created.", "Changing the region group forces a new project to be created."),
+				},
 			},
 			"instance_size": schema.StringAttribute{
-				MarkdownDescription: "Desired instance size of the project",
+				MarkdownDescription: "Desired instance size of the project, checked against the Management API at plan time. See the `supabase_instance_sizes` data source for available sizes.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is paused. Set to `true` to pause the project and `false` to restore it.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying or replacing the project. Must be set to `false` and applied before the project can be deleted.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the project API",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_host": schema.StringAttribute{
+				MarkdownDescription: "Host of the project database",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"postgres_version": schema.StringAttribute{
+				MarkdownDescription: "Postgres version of the project database. Set a major version, e.g. `17`, to select the engine on creation. Changing it on an existing project performs an in-place major upgrade.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"postgres_engine": schema.StringAttribute{
+				MarkdownDescription: "Postgres engine of the project database",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"release_channel": schema.StringAttribute{
+				MarkdownDescription: "Release channel of the project database, used on creation and for major upgrades",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -81,29 +222,29 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 				Validators: []validator.String{
 					stringvalidator.OneOf(
-						string(api.V1CreateProjectBodyDesiredInstanceSizeLarge),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeMedium),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeMicro),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN12xlarge),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN16xlarge),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN24xlarge),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN24xlargeHighMemory),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN24xlargeOptimizedCpu),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN24xlargeOptimizedMemory),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN2xlarge),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN48xlarge),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN48xlargeHighMemory),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN48xlargeOptimizedCpu),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN48xlargeOptimizedMemory),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN4xlarge),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeN8xlarge),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeNano),
-						string(api.V1CreateProjectBodyDesiredInstanceSizePico),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeSmall),
-						string(api.V1CreateProjectBodyDesiredInstanceSizeXlarge),
+						string(api.V1CreateProjectBodyReleaseChannelAlpha),
+						string(api.V1CreateProjectBodyReleaseChannelBeta),
+						string(api.V1CreateProjectBodyReleaseChannelGa),
+						string(api.V1CreateProjectBodyReleaseChannelInternal),
+						string(api.V1CreateProjectBodyReleaseChannelPreview),
+						string(api.V1CreateProjectBodyReleaseChannelWithdrawn),
 					),
 				},
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the project, e.g. `ACTIVE_HEALTHY` or `INACTIVE`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Project identifier",
 				Computed:            true,
@@ -115,6 +256,117 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *ProjectResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("database_password"),
+			path.MatchRoot("database_password_wo"),
+			path.MatchRoot("generate_database_password"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("region"),
+			path.MatchRoot("region_group"),
+		),
+	}
+}
+
+func (r *ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var generate types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("generate_database_password"), &generate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !generate.IsNull() && !generate.IsUnknown() && !generate.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("generate_database_password"),
+			"Invalid Attribute Value",
+			"generate_database_password can only be set to true. Remove it and set database_password or database_password_wo instead.",
+		)
+	}
+}
+
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// New projects only need their region and instance size checked
+	if req.State.Raw.IsNull() {
+		var plan ProjectResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(checkRegionAvailability(ctx, &plan, nil, r.client)...)
+		return
+	}
+
+	var state ProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Protected projects fail at plan time instead of during apply
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "Project", state.Id.ValueString())...)
+		return
+	}
+
+	var plan ProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if projectRequiresReplace(plan, state) {
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "Project", state.Id.ValueString())...)
+		return
+	}
+
+	// Rotate the generated password when it is first enabled or its keepers change
+	if plan.GenerateDatabasePassword.ValueBool() {
+		if !state.GenerateDatabasePassword.ValueBool() || !plan.DatabasePasswordKeepers.Equal(state.DatabasePasswordKeepers) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("generated_database_password"), types.StringUnknown())...)
+		}
+	} else if !state.GeneratedDatabasePassword.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("generated_database_password"), types.StringNull())...)
+	}
+	resp.Diagnostics.Append(checkRegionAvailability(ctx, &plan, &state, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Status changes once the project is paused or restored
+	if !plan.Paused.IsUnknown() && !plan.Paused.Equal(state.Paused) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	}
+	// Switching channels is only supported as part of a major version upgrade
+	var version types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("postgres_version"), &version)...)
+	if version.IsNull() && !plan.ReleaseChannel.IsUnknown() && !plan.ReleaseChannel.Equal(state.ReleaseChannel) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("release_channel"),
+			"Invalid Attribute Combination",
+			"release_channel can only be changed on an existing project together with postgres_version. Set postgres_version to the target major version to upgrade on the new release channel.",
+		)
+		return
+	}
+	if !postgresUpgradeRequired(plan, state) {
+		return
+	}
+
+	// Surface eligibility blockers before any changes are applied
+	channel, diags := checkUpgradeEligibility(ctx, &plan, &state, r.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("release_channel"), types.StringValue(channel))...)
+	// The engine is only known once the upgrade completes
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("postgres_engine"), types.StringUnknown())...)
+}
+
+// projectRequiresReplace reports whether the plan replaces the project. Attribute
+// plan modifiers don't expose their RequiresReplace results to ModifyPlan, so
+// this mirrors the replace conditions declared in the schema.
+func projectRequiresReplace(plan, state ProjectResourceModel) bool {
+	return !state.RegionGroup.IsNull() && !plan.RegionGroup.Equal(state.RegionGroup)
+}
+
 func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -138,16 +390,40 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are only available from config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("database_password_wo"), &data.DatabasePasswordWo)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data.GeneratedDatabasePassword = types.StringNull()
+	if data.GenerateDatabasePassword.ValueBool() {
+		password, err := generatePassword(databasePasswordLength)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to generate database password, got error: %s", err))
+			return
+		}
+		data.GeneratedDatabasePassword = types.StringValue(password)
+	}
+
 	tflog.Trace(ctx, "create project")
 	resp.Diagnostics.Append(createProject(ctx, &data, r.client)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if data.Paused.ValueBool() {
+		tflog.Trace(ctx, "pause project")
+		resp.Diagnostics.Append(waitForProjectStatus(ctx, data.Id.ValueString(), api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(pauseProject(ctx, &data, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "read up to date project")
 	resp.Diagnostics.Append(readProject(ctx, &data, r.client)...)
 	if resp.Diagnostics.HasError() {
@@ -183,15 +459,39 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("database_password_wo"), &plan.DatabasePasswordWo)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// A paused project must be restored before any other changes can be applied
+	togglePause := !plan.Paused.IsUnknown() && !plan.Paused.Equal(state.Paused)
+	if togglePause && !plan.Paused.ValueBool() {
+		resp.Diagnostics.Append(restoreProject(ctx, &plan, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// required attributes
 	if !plan.Name.Equal(state.Name) {
 		resp.Diagnostics.Append(updateName(ctx, &plan, r.client)...)
 	}
-	if !plan.DatabasePassword.Equal(state.DatabasePassword) {
+	if !plan.DatabasePassword.IsNull() && !plan.DatabasePassword.Equal(state.DatabasePassword) {
+		resp.Diagnostics.Append(updateDatabasePassword(ctx, &plan, r.client)...)
+	}
+	// write-only password is only rotated when its version changes
+	if !plan.DatabasePasswordWo.IsNull() && !plan.DatabasePasswordWoVersion.Equal(state.DatabasePasswordWoVersion) {
+		resp.Diagnostics.Append(updateDatabasePassword(ctx, &plan, r.client)...)
+	}
+	// generated password is rotated when marked unknown during plan
+	if plan.GeneratedDatabasePassword.IsUnknown() {
+		password, err := generatePassword(databasePasswordLength)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to generate database password, got error: %s", err))
+			return
+		}
+		plan.GeneratedDatabasePassword = types.StringValue(password)
 		resp.Diagnostics.Append(updateDatabasePassword(ctx, &plan, r.client)...)
 	}
 	if !plan.Region.Equal(state.Region) {
@@ -199,18 +499,34 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	if !plan.OrganizationId.Equal(state.OrganizationId) {
-		resp.Diagnostics.AddAttributeError(path.Root("organization_id"), "Client Error", "Update is not supported for this attribute")
-		return
+		resp.Diagnostics.Append(transferProject(ctx, &plan, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// optional attributes
 	if !plan.InstanceSize.IsNull() && !plan.InstanceSize.Equal(state.InstanceSize) {
 		resp.Diagnostics.Append(updateInstanceSize(ctx, &plan, r.client)...)
 	}
+	upgrade := postgresUpgradeRequired(plan, state)
+	if upgrade {
+		resp.Diagnostics.Append(upgradePostgresVersion(ctx, &plan, r.client)...)
+	}
+	if togglePause && plan.Paused.ValueBool() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(pauseProject(ctx, &plan, r.client)...)
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Refresh computed attributes that change with the database version or project status
+	if upgrade || togglePause {
+		resp.Diagnostics.Append(readProject(ctx, &plan, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -223,6 +539,11 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "Project", data.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(deleteProject(ctx, &data, r.client)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -236,16 +557,25 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Imported projects are protected the same way as newly created ones
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), types.BoolValue(true))...)
 }
 
 func createProject(ctx context.Context, data *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
-	regionSelection := api.V1CreateProjectBodyRegionSelection0{
-		Type: api.Specific,
-		Code: api.V1CreateProjectBodyRegionSelection0Code(data.Region.ValueString()),
-	}
-
 	region := api.V1CreateProjectBody_RegionSelection{}
-	if err := region.FromV1CreateProjectBodyRegionSelection0(regionSelection); err != nil {
+	var err error
+	if !data.RegionGroup.IsNull() {
+		err = region.FromV1CreateProjectBodyRegionSelection1(api.V1CreateProjectBodyRegionSelection1{
+			Type: api.SmartGroup,
+			Code: api.V1CreateProjectBodyRegionSelection1Code(data.RegionGroup.ValueString()),
+		})
+	} else {
+		err = region.FromV1CreateProjectBodyRegionSelection0(api.V1CreateProjectBodyRegionSelection0{
+			Type: api.Specific,
+			Code: api.V1CreateProjectBodyRegionSelection0Code(data.Region.ValueString()),
+		})
+	}
+	if err != nil {
 		return diag.Diagnostics{diag.NewErrorDiagnostic(
 			"Internal Error",
 			fmt.Sprintf("Failed to configure region selection: %s", err),
@@ -254,12 +584,18 @@ func createProject(ctx context.Context, data *ProjectResourceModel, client *api.
 	body := api.V1CreateAProjectJSONRequestBody{
 		OrganizationSlug: data.OrganizationId.ValueString(),
 		Name:             data.Name.ValueString(),
-		DbPass:           data.DatabasePassword.ValueString(),
+		DbPass:           data.databasePassword(),
 		RegionSelection:  &region,
 	}
 	if !data.InstanceSize.IsUnknown() && !data.InstanceSize.IsNull() {
 		body.DesiredInstanceSize = Ptr(api.V1CreateProjectBodyDesiredInstanceSize(data.InstanceSize.ValueString()))
 	}
+	if !data.PostgresVersion.IsUnknown() && !data.PostgresVersion.IsNull() {
+		body.PostgresEngine = Ptr(api.V1CreateProjectBodyPostgresEngine(data.PostgresVersion.ValueString()))
+	}
+	if !data.ReleaseChannel.IsUnknown() && !data.ReleaseChannel.IsNull() {
+		body.ReleaseChannel = Ptr(api.V1CreateProjectBodyReleaseChannel(data.ReleaseChannel.ValueString()))
+	}
 
 	httpResp, err := client.V1CreateAProjectWithResponse(ctx, body)
 	if err != nil {
@@ -296,20 +632,26 @@ func readProject(ctx context.Context, data *ProjectResourceModel, client *api.Cl
 	data.OrganizationId = types.StringValue(project.OrganizationId)
 	data.Name = types.StringValue(project.Name)
 	data.Region = types.StringValue(project.Region)
-	data.InstanceSize = types.StringNull()
-
-	addonsResp, err := client.V1ListProjectAddonsWithResponse(ctx, project.Id)
-	if err != nil {
-		msg := fmt.Sprintf("Unable to read project addons, got error: %s", err)
-		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	data.ApiUrl = types.StringValue(projectApiUrl(data.Id.ValueString()))
+	data.DatabaseHost = types.StringValue(project.Database.Host)
+	// Keep the configured major version as long as the database runs it
+	if data.PostgresVersion.IsUnknown() || data.PostgresVersion.IsNull() ||
+		!postgresVersionMatches(data.PostgresVersion.ValueString(), project.Database.Version, project.Database.PostgresEngine) {
+		data.PostgresVersion = types.StringValue(project.Database.Version)
 	}
+	data.PostgresEngine = types.StringValue(project.Database.PostgresEngine)
+	data.ReleaseChannel = types.StringValue(project.Database.ReleaseChannel)
+	data.Status = types.StringValue(string(project.Status))
+	data.Paused = types.BoolValue(projectPaused(project.Status))
+	data.CreatedAt = types.StringValue(project.CreatedAt)
+	data.InstanceSize = types.StringNull()
 
-	if addonsResp.JSON200 == nil {
-		msg := fmt.Sprintf("Unable to read project addons, got error: %s", string(addonsResp.Body))
-		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	addons, diags := listProjectAddons(ctx, project.Id, client)
+	if diags.HasError() {
+		return diags
 	}
 
-	for _, addon := range addonsResp.JSON200.SelectedAddons {
+	for _, addon := range addons.SelectedAddons {
 		if addon.Type != api.ComputeInstance {
 			continue
 		}
@@ -394,7 +736,7 @@ func updateName(ctx context.Context, plan *ProjectResourceModel, client *api.Cli
 
 func updateDatabasePassword(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
 	httpResp, err := client.V1UpdateDatabasePasswordWithResponse(ctx, plan.Id.ValueString(), api.V1UpdatePasswordBody{
-		Password: plan.DatabasePassword.ValueString(),
+		Password: plan.databasePassword(),
 	})
 	if err != nil {
 		msg := fmt.Sprintf("Unable to update database password, got error: %s", err)
@@ -408,3 +750,382 @@ func updateDatabasePassword(ctx context.Context, plan *ProjectResourceModel, cli
 
 	return nil
 }
+
+// postgresVersionMatches reports whether the desired version refers to the
+// running database, either verbatim, by major version or by engine.
+func postgresVersionMatches(desired, version, engine string) bool {
+	major, _, _ := strings.Cut(version, ".")
+	return desired == version || desired == major || desired == engine
+}
+
+// checkRegionAvailability validates the region of new projects against the
+// catalog read by the supabase_regions data source, and checks that the region
+// has capacity for the instance size of new and resized projects.
+func checkRegionAvailability(ctx context.Context, plan, state *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	org := plan.OrganizationId
+	if state != nil {
+		if plan.InstanceSize.IsUnknown() || plan.InstanceSize.IsNull() || plan.InstanceSize.Equal(state.InstanceSize) {
+			return nil
+		}
+		org = state.OrganizationId
+	}
+	if org.IsUnknown() {
+		return nil
+	}
+
+	// Unknown sizes are left for the API to pick
+	params := api.V1GetAvailableRegionsParams{OrganizationSlug: org.ValueString()}
+	if !plan.InstanceSize.IsUnknown() && !plan.InstanceSize.IsNull() {
+		params.DesiredInstanceSize = Ptr(api.V1GetAvailableRegionsParamsDesiredInstanceSize(plan.InstanceSize.ValueString()))
+	}
+	regions, diags := listAvailableRegions(ctx, params, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	if state == nil && !plan.RegionGroup.IsNull() && !plan.RegionGroup.IsUnknown() {
+		codes := make([]string, 0, len(regions.All.SmartGroup))
+		for _, group := range regions.All.SmartGroup {
+			if string(group.Code) == plan.RegionGroup.ValueString() {
+				return diags
+			}
+			codes = append(codes, string(group.Code))
+		}
+		diags.AddAttributeError(
+			path.Root("region_group"),
+			"Invalid Region Group",
+			fmt.Sprintf("Region group %q is not available, expected one of: %s", plan.RegionGroup.ValueString(), strings.Join(codes, ", ")),
+		)
+		return diags
+	}
+	if plan.Region.IsNull() || plan.Region.IsUnknown() {
+		return diags
+	}
+
+	codes := make([]string, 0, len(regions.All.Specific))
+	for _, region := range regions.All.Specific {
+		if region.Code != plan.Region.ValueString() {
+			codes = append(codes, region.Code)
+			continue
+		}
+		if region.Status != nil {
+			msg := fmt.Sprintf("Region %q currently reports status %q", region.Code, *region.Status)
+			if params.DesiredInstanceSize != nil {
+				msg += fmt.Sprintf(" for instance size %q", *params.DesiredInstanceSize)
+			}
+			if state == nil {
+				msg += ", the project may fail to provision."
+			} else {
+				msg += ", the resize may fail."
+			}
+			diags.AddAttributeWarning(path.Root("region"), "Region Unavailable", msg)
+		}
+		return diags
+	}
+	// Existing projects may live in regions that no longer accept new projects
+	if state == nil {
+		diags.AddAttributeError(
+			path.Root("region"),
+			"Invalid Region",
+			fmt.Sprintf("Region %q is not available, expected one of: %s", plan.Region.ValueString(), strings.Join(codes, ", ")),
+		)
+	}
+	return diags
+}
+
+// postgresUpgradeRequired reports whether the plan changes the major version
+// or release channel of the project database.
+func postgresUpgradeRequired(plan, state ProjectResourceModel) bool {
+	if plan.PostgresVersion.IsUnknown() || plan.PostgresVersion.IsNull() {
+		return false
+	}
+	if !postgresVersionMatches(plan.PostgresVersion.ValueString(), state.PostgresVersion.ValueString(), state.PostgresEngine.ValueString()) {
+		return true
+	}
+	return !plan.ReleaseChannel.IsUnknown() && !plan.ReleaseChannel.IsNull() && !plan.ReleaseChannel.Equal(state.ReleaseChannel)
+}
+
+// checkUpgradeEligibility verifies that the project can be upgraded to the
+// planned version and returns the release channel of the matching target.
+func checkUpgradeEligibility(ctx context.Context, plan, state *ProjectResourceModel, client *api.ClientWithResponses) (string, diag.Diagnostics) {
+	httpResp, err := client.V1GetPostgresUpgradeEligibilityWithResponse(ctx, state.Id.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read upgrade eligibility, got error: %s", err)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read upgrade eligibility, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	eligibility := httpResp.JSON200
+	version := plan.PostgresVersion.ValueString()
+	if !eligibility.Eligible {
+		var blockers []string
+		if len(eligibility.UnsupportedExtensions) > 0 {
+			blockers = append(blockers, "unsupported extensions: "+strings.Join(eligibility.UnsupportedExtensions, ", "))
+		}
+		if len(eligibility.ObjectsToBeDropped) > 0 {
+			blockers = append(blockers, "objects to be dropped: "+strings.Join(eligibility.ObjectsToBeDropped, ", "))
+		}
+		if len(eligibility.UserDefinedObjectsInInternalSchemas) > 0 {
+			blockers = append(blockers, "user defined objects in internal schemas: "+strings.Join(eligibility.UserDefinedObjectsInInternalSchemas, ", "))
+		}
+		if len(eligibility.LegacyAuthCustomRoles) > 0 {
+			blockers = append(blockers, "legacy auth custom roles: "+strings.Join(eligibility.LegacyAuthCustomRoles, ", "))
+		}
+		msg := fmt.Sprintf("Project %s is not eligible for an upgrade to Postgres %s.", state.Id.ValueString(), version)
+		if len(blockers) > 0 {
+			msg += "\n\nResolve the following before upgrading:\n  - " + strings.Join(blockers, "\n  - ")
+		}
+		return "", diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("postgres_version"), "Upgrade Not Eligible", msg)}
+	}
+
+	// Prefer the planned release channel, falling back to any channel offering the version
+	channel := plan.ReleaseChannel.ValueString()
+	var available []string
+	var found string
+	for _, target := range eligibility.TargetUpgradeVersions {
+		available = append(available, fmt.Sprintf("%s (%s)", target.PostgresVersion, target.ReleaseChannel))
+		if string(target.PostgresVersion) != version {
+			continue
+		}
+		if string(target.ReleaseChannel) == channel {
+			return channel, nil
+		}
+		if len(found) == 0 {
+			found = string(target.ReleaseChannel)
+		}
+	}
+	if len(found) == 0 {
+		msg := fmt.Sprintf("Postgres %s is not an available upgrade target for project %s. Available targets: %s", version, state.Id.ValueString(), strings.Join(available, ", "))
+		return "", diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("postgres_version"), "Upgrade Not Eligible", msg)}
+	}
+	if !plan.ReleaseChannel.Equal(state.ReleaseChannel) {
+		msg := fmt.Sprintf("Postgres %s is not available on the %s release channel. Available targets: %s", version, channel, strings.Join(available, ", "))
+		return "", diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("release_channel"), "Upgrade Not Eligible", msg)}
+	}
+	return found, nil
+}
+
+func upgradePostgresVersion(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	body := api.UpgradeDatabaseBody{
+		TargetVersion: plan.PostgresVersion.ValueString(),
+	}
+	if !plan.ReleaseChannel.IsUnknown() && !plan.ReleaseChannel.IsNull() {
+		body.ReleaseChannel = Ptr(api.UpgradeDatabaseBodyReleaseChannel(plan.ReleaseChannel.ValueString()))
+	}
+
+	httpResp, err := client.V1UpgradePostgresVersionWithResponse(ctx, plan.Id.ValueString(), body)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to upgrade postgres version, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON201 == nil {
+		msg := fmt.Sprintf("Unable to upgrade postgres version, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return waitForUpgrade(ctx, plan.Id.ValueString(), httpResp.JSON201.TrackingId, client)
+}
+
+// waitForUpgrade polls the upgrade status until the upgraded database is
+// running, failing early if any stage of the upgrade reports an error.
+func waitForUpgrade(ctx context.Context, projectRef, trackingId string, client *api.ClientWithResponses) diag.Diagnostics {
+	params := api.V1GetPostgresUpgradeStatusParams{TrackingId: &trackingId}
+	return waitFor(ctx, projectUpgradeTimeout, "postgres upgrade to complete", func(ctx context.Context) (bool, diag.Diagnostics) {
+		httpResp, err := client.V1GetPostgresUpgradeStatusWithResponse(ctx, projectRef, &params)
+		if err != nil {
+			msg := fmt.Sprintf("Unable to read upgrade status, got error: %s", err)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		if httpResp.JSON200 == nil {
+			msg := fmt.Sprintf("Unable to read upgrade status, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		// Status may not be reported until the upgrade is scheduled
+		status, err := httpResp.JSON200.DatabaseUpgradeStatus.Get()
+		if err != nil {
+			return false, nil
+		}
+		if status.Error != nil {
+			msg := fmt.Sprintf("Postgres upgrade of project %s failed with error %s", projectRef, *status.Error)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Upgrade Error", msg)}
+		}
+		if status.Progress == nil {
+			return false, nil
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("upgrade progress: %s", *status.Progress))
+		switch *status.Progress {
+		case api.N9CompletedUpgrade, api.N10CompletedPostPhysicalBackup:
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// transferProject moves the project to the planned organization by claiming it
+// with a short-lived claim token, after checking the transfer preview.
+func transferProject(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	projectRef := plan.Id.ValueString()
+	orgSlug := plan.OrganizationId.ValueString()
+
+	tokenResp, err := client.V1CreateProjectClaimTokenWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to create project claim token, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if tokenResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to create project claim token, got status %d: %s", tokenResp.StatusCode(), tokenResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	token := tokenResp.JSON200.Token
+
+	diags := previewProjectTransfer(ctx, projectRef, orgSlug, token, client)
+	if diags.HasError() {
+		// Revoke the unused token so that it cannot be claimed later
+		if _, err := client.V1DeleteProjectClaimTokenWithResponse(ctx, projectRef); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("failed to delete project claim token: %s", err))
+		}
+		return diags
+	}
+
+	claimResp, err := client.V1ClaimProjectForOrganizationWithResponse(ctx, orgSlug, token)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to transfer project, got error: %s", err)
+		return append(diags, diag.NewErrorDiagnostic("Client Error", msg))
+	}
+	if claimResp.StatusCode() < http.StatusOK || claimResp.StatusCode() >= http.StatusMultipleChoices {
+		msg := fmt.Sprintf("Unable to transfer project, got status %d: %s", claimResp.StatusCode(), claimResp.Body)
+		return append(diags, diag.NewErrorDiagnostic("Client Error", msg))
+	}
+
+	return append(diags, waitFor(ctx, projectStatusTimeout, fmt.Sprintf("project to appear in organization %s", orgSlug), func(ctx context.Context) (bool, diag.Diagnostics) {
+		httpResp, err := client.V1GetProjectWithResponse(ctx, projectRef)
+		if err != nil {
+			msg := fmt.Sprintf("Unable to read project, got error: %s", err)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		if httpResp.JSON200 == nil {
+			msg := fmt.Sprintf("Unable to read project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		project := httpResp.JSON200
+		return project.OrganizationId == orgSlug || project.OrganizationSlug == orgSlug, nil
+	})...)
+}
+
+// previewProjectTransfer reports the warnings of a transfer as warning diagnostics
+// and fails if the target organization cannot take over the project.
+func previewProjectTransfer(ctx context.Context, projectRef, orgSlug, token string, client *api.ClientWithResponses) diag.Diagnostics {
+	httpResp, err := client.V1GetOrganizationProjectClaimWithResponse(ctx, orgSlug, token)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to preview project transfer, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to preview project transfer, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	preview := httpResp.JSON200.Preview
+	var diags diag.Diagnostics
+	for _, warning := range preview.Warnings {
+		diags.AddAttributeWarning(path.Root("organization_id"), "Project Transfer Warning", warning.Message)
+	}
+	for _, blocker := range preview.Errors {
+		diags.AddAttributeError(path.Root("organization_id"), "Project Transfer Error", blocker.Message)
+	}
+	for _, member := range preview.MembersExceedingFreeProjectLimit {
+		msg := fmt.Sprintf("Member %s would exceed the limit of %.0f free projects", member.Name, member.Limit)
+		diags.AddAttributeWarning(path.Root("organization_id"), "Project Transfer Warning", msg)
+	}
+	if !preview.Valid && !diags.HasError() {
+		msg := fmt.Sprintf("Project %s cannot be transferred to organization %s", projectRef, orgSlug)
+		diags.AddAttributeError(path.Root("organization_id"), "Project Transfer Error", msg)
+	}
+	return diags
+}
+
+// projectPaused reports whether the project is paused or in the process of pausing.
+func projectPaused(status api.V1ProjectWithDatabaseResponseStatus) bool {
+	switch status {
+	case api.V1ProjectWithDatabaseResponseStatusINACTIVE, api.V1ProjectWithDatabaseResponseStatusPAUSING:
+		return true
+	}
+	return false
+}
+
+func pauseProject(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	httpResp, err := client.V1PauseAProjectWithResponse(ctx, plan.Id.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to pause project, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to pause project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return waitForProjectStatus(ctx, plan.Id.ValueString(), api.V1ProjectWithDatabaseResponseStatusINACTIVE, client)
+}
+
+func restoreProject(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	httpResp, err := client.V1RestoreAProjectWithResponse(ctx, plan.Id.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to restore project, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to restore project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return waitForProjectStatus(ctx, plan.Id.ValueString(), api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY, client)
+}
+
+// waitForProjectStatus polls the project until it reaches the desired status,
+// failing early if a pause or restore reports an error.
+func waitForProjectStatus(ctx context.Context, projectRef string, want api.V1ProjectWithDatabaseResponseStatus, client *api.ClientWithResponses) diag.Diagnostics {
+	return waitFor(ctx, projectStatusTimeout, fmt.Sprintf("project to be %s", want), func(ctx context.Context) (bool, diag.Diagnostics) {
+		httpResp, err := client.V1GetProjectWithResponse(ctx, projectRef)
+		if err != nil {
+			msg := fmt.Sprintf("Unable to read project, got error: %s", err)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		if httpResp.JSON200 == nil {
+			msg := fmt.Sprintf("Unable to read project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+
+		status := httpResp.JSON200.Status
+		tflog.Trace(ctx, fmt.Sprintf("project status: %s", status))
+		switch status {
+		case want:
+			return true, nil
+		case api.V1ProjectWithDatabaseResponseStatusPAUSEFAILED, api.V1ProjectWithDatabaseResponseStatusRESTOREFAILED, api.V1ProjectWithDatabaseResponseStatusINITFAILED:
+			msg := fmt.Sprintf("Project %s reported status %s while waiting for %s", projectRef, status, want)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Project Status Error", msg)}
+		}
+		return false, nil
+	})
+}
+
+// explainPausedProject adds a diagnostic explaining that the project is paused
+// when a request against it failed, since the API only reports a generic error.
+func explainPausedProject(ctx context.Context, projectRef string, client *api.ClientWithResponses, diags diag.Diagnostics) diag.Diagnostics {
+	if !diags.HasError() {
+		return diags
+	}
+
+	httpResp, err := client.V1GetProjectWithResponse(ctx, projectRef)
+	if err != nil || httpResp.JSON200 == nil || !projectPaused(httpResp.JSON200.Status) {
+		return diags
+	}
+
+	msg := fmt.Sprintf("Project %s is %s. Restore it from the dashboard or set `paused = false` on its supabase_project resource, then apply again.", projectRef, httpResp.JSON200.Status)
+	return append(diags, diag.NewErrorDiagnostic("Project Paused", msg))
+}
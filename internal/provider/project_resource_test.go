@@ -4,11 +4,19 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/supabase/cli/pkg/api"
 	"github.com/supabase/terraform-provider-supabase/examples"
 	"gopkg.in/h2non/gock.v1"
@@ -17,6 +25,11 @@ import (
 func TestAccProjectResource(t *testing.T) {
 	// Setup mock api
 	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
 	// Step 1: create
 	gock.New("https://api.supabase.com").
 		Post("/v1/projects").
@@ -33,6 +46,8 @@ func TestAccProjectResource(t *testing.T) {
 			Name:           "foo",
 			OrganizationId: "continued-brown-smelt",
 			Region:         "us-east-1",
+			Status:         api.V1ProjectResponseStatusACTIVEHEALTHY,
+			CreatedAt:      "2025-01-01T00:00:00Z",
 		})
 	gock.New("https://api.supabase.com").
 		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
@@ -58,6 +73,8 @@ func TestAccProjectResource(t *testing.T) {
 			Name:           "foo",
 			OrganizationId: "continued-brown-smelt",
 			Region:         "us-east-1",
+			Status:         api.V1ProjectResponseStatusACTIVEHEALTHY,
+			CreatedAt:      "2025-01-01T00:00:00Z",
 		})
 	gock.New("https://api.supabase.com").
 		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
@@ -84,6 +101,8 @@ func TestAccProjectResource(t *testing.T) {
 			Name:           "foo",
 			OrganizationId: "continued-brown-smelt",
 			Region:         "us-east-1",
+			Status:         api.V1ProjectResponseStatusACTIVEHEALTHY,
+			CreatedAt:      "2025-01-01T00:00:00Z",
 		})
 	gock.New("https://api.supabase.com").
 		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
@@ -118,6 +137,8 @@ func TestAccProjectResource(t *testing.T) {
 			Name:           "bar",
 			OrganizationId: "continued-brown-smelt",
 			Region:         "us-east-1",
+			Status:         api.V1ProjectResponseStatusACTIVEHEALTHY,
+			CreatedAt:      "2025-01-01T00:00:00Z",
 		})
 	gock.New("https://api.supabase.com").
 		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
@@ -144,6 +165,8 @@ func TestAccProjectResource(t *testing.T) {
 			Name:           "bar",
 			OrganizationId: "continued-brown-smelt",
 			Region:         "us-east-1",
+			Status:         api.V1ProjectResponseStatusACTIVEHEALTHY,
+			CreatedAt:      "2025-01-01T00:00:00Z",
 		})
 	gock.New("https://api.supabase.com").
 		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
@@ -183,6 +206,9 @@ func TestAccProjectResource(t *testing.T) {
 					resource.TestCheckResourceAttr("supabase_project.test", "name", "foo"),
 					resource.TestCheckResourceAttr("supabase_project.test", "instance_size", "micro"),
 					resource.TestCheckResourceAttr("supabase_project.test", "database_password", "barbaz"),
+					resource.TestCheckResourceAttr("supabase_project.test", "api_url", "https://mayuaycdtijbctgqbycg.supabase.co"),
+					resource.TestCheckResourceAttr("supabase_project.test", "status", "ACTIVE_HEALTHY"),
+					resource.TestCheckResourceAttr("supabase_project.test", "created_at", "2025-01-01T00:00:00Z"),
 				),
 			},
 			// Update instance size testing
@@ -213,10 +239,994 @@ func TestAccProjectResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 
-				// database_password is not refreshed from the API
-				ImportStateVerifyIgnore: []string{"database_password"},
+				// database_password and deletion_protection are not refreshed from the API
+				ImportStateVerifyIgnore: []string{"database_password", "deletion_protection"},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestAccProjectResourceWriteOnlyPassword(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	// Step 1: create with write-only password
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects").
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectResponse{
+			Id:             "mayuaycdtijbctgqbycg",
+			Name:           "foo",
+			OrganizationId: "continued-brown-smelt",
+			Region:         "us-east-1",
+			Status:         api.V1ProjectResponseStatusACTIVEHEALTHY,
+			CreatedAt:      "2025-01-01T00:00:00Z",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons": []map[string]any{
+				{
+					"type": "compute_instance",
+					"variant": map[string]any{
+						"id":    api.ListProjectAddonsResponseAvailableAddonsVariantsId0CiMicro,
+						"name":  "Micro",
+						"price": map[string]any{},
+					},
+				},
+			},
+			"available_addons": []map[string]any{},
+		})
+	// Step 2: rotate password by bumping version
+	gock.New("https://api.supabase.com").
+		Patch("/v1/projects/mayuaycdtijbctgqbycg/database/password").
+		JSON(api.V1UpdatePasswordBody{Password: "barbaznew"}).
+		Reply(http.StatusOK)
+	// Step 3: delete
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceWriteOnlyConfig("barbaz", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "id", "mayuaycdtijbctgqbycg"),
+					resource.TestCheckResourceAttr("supabase_project.test", "database_password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password_wo"),
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password"),
+				),
+			},
+			// Rotate password testing
+			{
+				Config: testAccProjectResourceWriteOnlyConfig("barbaznew", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "database_password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password_wo"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceWriteOnlyConfig(password string, version int) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id              = "continued-brown-smelt"
+  name                         = "foo"
+  database_password_wo         = %[1]q
+  database_password_wo_version = %[2]d
+  region                       = "us-east-1"
+  instance_size                = "micro"
+  deletion_protection          = false
+}
+`, password, version)
+}
+
+func TestAccProjectResourcePaused(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	paused := false
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects$").
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return !paused, nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("15.8.1.044", "15"))
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return paused, nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithStatus(api.V1ProjectWithDatabaseResponseStatusINACTIVE))
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons":  []map[string]any{},
+			"available_addons": []map[string]any{},
+		})
+	// Step 2: pause
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/pause").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) {
+			paused = true
+			return true, nil
+		}).
+		Reply(http.StatusOK)
+	// Step 3: restore
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/restore").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) {
+			paused = false
+			return true, nil
+		}).
+		Reply(http.StatusOK)
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourcePausedConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "paused", "false"),
+					resource.TestCheckResourceAttr("supabase_project.test", "status", "ACTIVE_HEALTHY"),
+				),
+			},
+			// Pause testing
+			{
+				Config: testAccProjectResourcePausedConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "paused", "true"),
+					resource.TestCheckResourceAttr("supabase_project.test", "status", "INACTIVE"),
+				),
+			},
+			// Restore testing
+			{
+				Config: testAccProjectResourcePausedConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "paused", "false"),
+					resource.TestCheckResourceAttr("supabase_project.test", "status", "ACTIVE_HEALTHY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceRegionGroup(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects$").
+		AddMatcher(testAccBodyContains(`"region_selection":{"code":"emea","type":"smartGroup"}`)).
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	project := testAccProjectWithDatabase("15.8.1.044", "15")
+	project["region"] = "eu-central-1"
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(project)
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons":  []map[string]any{},
+			"available_addons": []map[string]any{},
+		})
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The chosen region is recorded without causing a diff
+			{
+				Config: testAccProjectResourceRegionGroupConfig("emea", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "region", "eu-central-1"),
+					resource.TestCheckResourceAttr("supabase_project.test", "region_group", "emea"),
+				),
+			},
+			// Moving to another group requires a new project
+			{
+				Config:             testAccProjectResourceRegionGroupConfig("apac", false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPreRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("supabase_project.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			// Replacing a protected project fails at plan time
+			{
+				Config: testAccProjectResourceRegionGroupConfig("emea", true),
+			},
+			{
+				Config:      testAccProjectResourceRegionGroupConfig("apac", true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`deletion protection enabled`),
+			},
+			// Disable protection so that the project can be destroyed
+			{
+				Config: testAccProjectResourceRegionGroupConfig("emea", false),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceRegionGroupConfig(group string, protected bool) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id     = "continued-brown-smelt"
+  name                = "foo"
+  database_password   = "barbaz"
+  region_group        = %q
+  deletion_protection = %t
+}
+`, group, protected)
+}
+
+func TestAccProjectResourceDeletionProtection(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects$").
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("15.8.1.044", "15"))
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons":  []map[string]any{},
+			"available_addons": []map[string]any{},
+		})
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	config := `
+resource "supabase_project" "test" {
+  organization_id   = "continued-brown-smelt"
+  name              = "foo"
+  database_password = "barbaz"
+  region            = "us-east-1"
+}
+`
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "deletion_protection", "true"),
+				),
+			},
+			// Destroy is blocked while protection is enabled
+			{
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion protection enabled`),
+			},
+			// Disable protection so that the project can be destroyed
+			{
+				Config: strings.ReplaceAll(config, `"us-east-1"`, "\"us-east-1\"\n  deletion_protection = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "deletion_protection", "false"),
+				),
+			},
+			// Imported projects are protected regardless of the prior state
+			{
+				ResourceName:      "supabase_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck: func(is []*terraform.InstanceState) error {
+					if len(is) != 1 {
+						return fmt.Errorf("expected a single resource in the state, got %d", len(is))
+					}
+					if protected := is[0].Attributes["deletion_protection"]; protected != "true" {
+						return fmt.Errorf("expected deletion_protection to be true, got %s", protected)
+					}
+					return nil
+				},
+				// database_password and deletion_protection are not refreshed from the API
+				ImportStateVerifyIgnore: []string{"database_password", "deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceTransfer(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	transferred := false
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects$").
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return !transferred, nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("15.8.1.044", "15"))
+	project := testAccProjectWithDatabase("15.8.1.044", "15")
+	project["organization_id"] = "nimble-green-heron"
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return transferred, nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(project)
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons":  []map[string]any{},
+			"available_addons": []map[string]any{},
+		})
+	// Step 2: transfer to another organization
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/claim-token").
+		Reply(http.StatusOK).
+		JSON(api.CreateProjectClaimTokenResponse{
+			Token:      "claim-token",
+			TokenAlias: "claim",
+			CreatedAt:  "2025-01-01T00:00:00Z",
+			ExpiresAt:  "2025-01-02T00:00:00Z",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/organizations/nimble-green-heron/project-claim/claim-token").
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"created_at": "2025-01-01T00:00:00Z",
+			"created_by": "4a5c2a4f-8e3b-4c9e-9a5b-2f1d3c4b5a6e",
+			"expires_at": "2025-01-02T00:00:00Z",
+			"project":    map[string]any{"name": "foo", "ref": "mayuaycdtijbctgqbycg"},
+			"preview": map[string]any{
+				"valid":                                true,
+				"errors":                               []map[string]any{},
+				"info":                                 []map[string]any{},
+				"members_exceeding_free_project_limit": []map[string]any{},
+				"source_subscription_plan":             "free",
+				"target_subscription_plan":             "pro",
+				"warnings": []map[string]any{
+					{"key": "plan", "message": "The project will be billed on the Pro plan"},
+				},
+			},
+		})
+	gock.New("https://api.supabase.com").
+		Post("/v1/organizations/nimble-green-heron/project-claim/claim-token").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) {
+			transferred = true
+			return true, nil
+		}).
+		Reply(http.StatusNoContent)
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceOrganizationConfig("continued-brown-smelt"),
+			},
+			// Transfer testing
+			{
+				Config: testAccProjectResourceOrganizationConfig("nimble-green-heron"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "organization_id", "nimble-green-heron"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceGeneratedPassword(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	var generated string
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects$").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			var body api.V1CreateProjectBody
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return false, err
+			}
+			generated = body.DbPass
+			return len(generated) == 32, nil
+		}).
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("15.8.1.044", "15"))
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons":  []map[string]any{},
+			"available_addons": []map[string]any{},
+		})
+	// Step 2: rotate password by changing keepers
+	gock.New("https://api.supabase.com").
+		Patch("/v1/projects/mayuaycdtijbctgqbycg/database/password").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			var body api.V1UpdatePasswordBody
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return false, err
+			}
+			rotated := body.Password != generated
+			generated = body.Password
+			return rotated, nil
+		}).
+		Reply(http.StatusOK)
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	checkGenerated := resource.TestCheckResourceAttrWith("supabase_project.test", "generated_database_password", func(value string) error {
+		if value != generated {
+			return fmt.Errorf("expected generated password to match the one sent to the API")
+		}
+		return nil
+	})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceGeneratedPasswordConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("supabase_project.test", "generated_database_password", regexp.MustCompile(`^[A-Za-z0-9]{32}$`)),
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password"),
+					checkGenerated,
+				),
+			},
+			// Rotate password testing
+			{
+				Config: testAccProjectResourceGeneratedPasswordConfig("2"),
+				Check:  checkGenerated,
+			},
+		},
+	})
+}
+
+func TestAccProjectResourcePostgresUpgrade(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	upgraded := false
+	// Step 1: create on postgres 15
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects$").
+		AddMatcher(testAccBodyContains(`"postgres_engine":"15"`, `"release_channel":"ga"`)).
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return !upgraded, nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("15.8.1.044", "15"))
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons":  []map[string]any{},
+			"available_addons": []map[string]any{},
+		})
+	// Step 2: upgrade to postgres 17
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/upgrade/eligibility").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"eligible":                                 true,
+			"current_app_version":                      "supabase-postgres-15.8.1.044",
+			"current_app_version_release_channel":      "ga",
+			"latest_app_version":                       "supabase-postgres-17.4.1.054",
+			"duration_estimate_hours":                  1,
+			"legacy_auth_custom_roles":                 []string{},
+			"objects_to_be_dropped":                    []string{},
+			"unsupported_extensions":                   []string{},
+			"user_defined_objects_in_internal_schemas": []string{},
+			"target_upgrade_versions": []map[string]any{
+				{"postgres_version": "17", "release_channel": "ga", "app_version": "supabase-postgres-17.4.1.054"},
+			},
+		})
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects/mayuaycdtijbctgqbycg/upgrade$").
+		JSON(api.UpgradeDatabaseBody{
+			TargetVersion:  "17",
+			ReleaseChannel: Ptr(api.UpgradeDatabaseBodyReleaseChannelGa),
+		}).
+		Reply(http.StatusCreated).
+		JSON(api.ProjectUpgradeInitiateResponse{TrackingId: "tracking-1"})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/upgrade/status").
+		MatchParam("tracking_id", "tracking-1").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) {
+			upgraded = true
+			return true, nil
+		}).
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"databaseUpgradeStatus": map[string]any{
+				"initiated_at":     "2025-01-01T00:00:00Z",
+				"latest_status_at": "2025-01-01T01:00:00Z",
+				"progress":         api.N9CompletedUpgrade,
+				"status":           2,
+				"target_version":   17,
+			},
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("17.4.1.054", "17"))
+	// Step 3: delete
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with version testing
+			{
+				Config: testAccProjectResourcePostgresConfig("15"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "postgres_version", "15"),
+					resource.TestCheckResourceAttr("supabase_project.test", "postgres_engine", "15"),
+					resource.TestCheckResourceAttr("supabase_project.test", "release_channel", "ga"),
+				),
+			},
+			// Major upgrade testing
+			{
+				Config: testAccProjectResourcePostgresConfig("17"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "postgres_version", "17"),
+					resource.TestCheckResourceAttr("supabase_project.test", "postgres_engine", "17"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectResourcePostgresUpgradeIneligible(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects$").
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("15.8.1.044", "15"))
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons":  []map[string]any{},
+			"available_addons": []map[string]any{},
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/upgrade/eligibility").
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"eligible":                                 false,
+			"current_app_version":                      "supabase-postgres-15.8.1.044",
+			"current_app_version_release_channel":      "ga",
+			"latest_app_version":                       "supabase-postgres-17.4.1.054",
+			"duration_estimate_hours":                  1,
+			"legacy_auth_custom_roles":                 []string{},
+			"objects_to_be_dropped":                    []string{},
+			"unsupported_extensions":                   []string{"timescaledb"},
+			"user_defined_objects_in_internal_schemas": []string{},
+			"target_upgrade_versions":                  []map[string]any{},
+		})
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourcePostgresConfig("15"),
+			},
+			// Blockers are reported at plan time
+			{
+				Config:      testAccProjectResourcePostgresConfig("17"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`unsupported extensions: timescaledb`),
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceReleaseChannelOnly(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	gock.New("https://api.supabase.com").
+		Post("/v1/projects$").
+		AddMatcher(testAccBodyContains(`"release_channel":"ga"`)).
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjectWithDatabase("15.8.1.044", "15"))
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/billing/addons").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons":  []map[string]any{},
+			"available_addons": []map[string]any{},
+		})
+	gock.New("https://api.supabase.com").
+		Delete("/v1/projects/mayuaycdtijbctgqbycg").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{
+			Id:   1,
+			Ref:  "mayuaycdtijbctgqbycg",
+			Name: "foo",
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceReleaseChannelConfig("ga"),
+			},
+			// Changing the channel without a target version is rejected at plan time
+			{
+				Config:      testAccProjectResourceReleaseChannelConfig("beta"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`release_channel can only be changed`),
+			},
+		},
+	})
+}
+
+func testAccProjectWithStatus(status api.V1ProjectWithDatabaseResponseStatus) map[string]any {
+	project := testAccProjectWithDatabase("15.8.1.044", "15")
+	project["status"] = status
+	return project
+}
+
+// testAccBodyContains matches requests whose body contains all of the given substrings.
+func testAccBodyContains(substrs ...string) gock.MatchFunc {
+	return func(req *http.Request, _ *gock.Request) (bool, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return false, err
+		}
+		req.Body = io.NopCloser(bytes.NewBuffer(body))
+		for _, substr := range substrs {
+			if !strings.Contains(string(body), substr) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// testAccMocksDone checks that all of the given mocks have been consumed.
+func testAccMocksDone(responses ...*gock.Response) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		for _, resp := range responses {
+			if !resp.Mock.Done() {
+				return fmt.Errorf("mock %s %s was not called", resp.Mock.Request().Method, resp.Mock.Request().URLStruct)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccProjectWithDatabase(version, engine string) map[string]any {
+	return map[string]any{
+		"id":                "mayuaycdtijbctgqbycg",
+		"ref":               "mayuaycdtijbctgqbycg",
+		"name":              "foo",
+		"organization_id":   "continued-brown-smelt",
+		"organization_slug": "continued-brown-smelt",
+		"region":            "us-east-1",
+		"status":            api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY,
+		"created_at":        "2025-01-01T00:00:00Z",
+		"database": map[string]any{
+			"host":            "db.mayuaycdtijbctgqbycg.supabase.co",
+			"version":         version,
+			"postgres_engine": engine,
+			"release_channel": "ga",
+		},
+	}
+}
+
+func testAccProjectResourcePostgresConfig(version string) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id     = "continued-brown-smelt"
+  name                = "foo"
+  database_password   = "barbaz"
+  region              = "us-east-1"
+  postgres_version    = %[1]q
+  release_channel     = "ga"
+  deletion_protection = false
+}
+`, version)
+}
+
+func testAccProjectResourceReleaseChannelConfig(channel string) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id     = "continued-brown-smelt"
+  name                = "foo"
+  database_password   = "barbaz"
+  region              = "us-east-1"
+  release_channel     = %[1]q
+  deletion_protection = false
+}
+`, channel)
+}
+
+func testAccProjectResourcePausedConfig(paused bool) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id     = "continued-brown-smelt"
+  name                = "foo"
+  database_password   = "barbaz"
+  region              = "us-east-1"
+  paused              = %[1]t
+  deletion_protection = false
+}
+`, paused)
+}
+
+func testAccProjectResourceOrganizationConfig(organization string) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id     = %[1]q
+  name                = "foo"
+  database_password   = "barbaz"
+  region              = "us-east-1"
+  deletion_protection = false
+}
+`, organization)
+}
+
+func testAccProjectResourceGeneratedPasswordConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id            = "continued-brown-smelt"
+  name                       = "foo"
+  generate_database_password = true
+  region                     = "us-east-1"
+  deletion_protection        = false
+
+  database_password_keepers = {
+    rotation = %[1]q
+  }
+}
+`, rotation)
+}
+
+func TestAccProjectResourceUnavailableRegion(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		MatchParam("desired_instance_size", "^huge$").
+		Persist().
+		Reply(http.StatusBadRequest).
+		JSON(map[string]any{"message": "desired_instance_size must be a valid instance size"})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.ReplaceAll(examples.ProjectResourceConfig, "us-east-1", "mars-north-1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Region "mars-north-1" is not available`),
+			},
+			{
+				Config:      strings.ReplaceAll(examples.ProjectResourceConfig, "micro", "huge"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Instance size "huge" is not supported`),
+			},
+		},
+	})
+}
+
+func testAccAvailableRegions() map[string]any {
+	return map[string]any{
+		"all": map[string]any{
+			"smartGroup": []map[string]any{
+				{"code": "americas", "name": "Americas", "type": "smartGroup"},
+				{"code": "emea", "name": "Europe, Middle East and Africa", "type": "smartGroup"},
+				{"code": "apac", "name": "Asia-Pacific", "type": "smartGroup"},
+			},
+			"specific": []map[string]any{
+				{"code": "us-east-1", "name": "East US (North Virginia)", "provider": "AWS", "type": "specific"},
+				{"code": "eu-central-1", "name": "Central EU (Frankfurt)", "provider": "AWS", "type": "specific"},
+				{"code": "ap-southeast-1", "name": "Southeast Asia (Singapore)", "provider": "AWS", "type": "specific", "status": "capacity"},
+			},
+		},
+		"recommendations": map[string]any{
+			"smartGroup": map[string]any{"code": "americas", "name": "Americas", "type": "smartGroup"},
+			"specific": []map[string]any{
+				{"code": "us-east-1", "name": "East US (North Virginia)", "provider": "AWS", "type": "specific"},
+			},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &ProjectRestartAction{}
+var _ action.ActionWithConfigure = &ProjectRestartAction{}
+
+func NewProjectRestartAction() action.Action {
+	return &ProjectRestartAction{}
+}
+
+// ProjectRestartAction defines the action implementation.
+type ProjectRestartAction struct {
+	client *api.ClientWithResponses
+}
+
+// ProjectRestartActionModel describes the action data model.
+type ProjectRestartActionModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+}
+
+func (a *ProjectRestartAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_restart"
+}
+
+func (a *ProjectRestartAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project restart action. Restarts the project database and waits for it to become healthy again.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *ProjectRestartAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *ProjectRestartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ProjectRestartActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restarting database of project %s", data.ProjectRef.ValueString()),
+	})
+	resp.Diagnostics.Append(restartDatabase(ctx, data.ProjectRef.ValueString(), a.client)...)
+	resp.Diagnostics = explainPausedProject(ctx, data.ProjectRef.ValueString(), a.client, resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "invoked project restart action")
+}
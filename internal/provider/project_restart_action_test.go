@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccProjectRestartAction(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Put("/v1/projects/mayuaycdtijbctgqbycg/config/database/postgres").
+		// Only the restart is requested so that settings are left untouched
+		AddMatcher(testAccBodyContains(`{"restart_database":true}`)).
+		Reply(http.StatusOK).
+		JSON(api.PostgresConfigResponse{
+			StatementTimeout: Ptr("10s"),
+		})
+	down := gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/health").
+		MatchParam("services", "db").
+		Reply(http.StatusOK).
+		JSON([]api.V1ServiceHealthResponse{{
+			Name:    api.V1ServiceHealthResponseNameDb,
+			Healthy: false,
+			Status:  api.COMINGUP,
+		}})
+	up := gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/health").
+		MatchParam("services", "db").
+		Reply(http.StatusOK).
+		JSON([]api.V1ServiceHealthResponse{{
+			Name:    api.V1ServiceHealthResponseNameDb,
+			Healthy: true,
+			Status:  api.ACTIVEHEALTHY,
+		}})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: examples.ProjectRestartActionConfig,
+				// The database goes down before it reports healthy again
+				Check: testAccMocksDone(down, up),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testAccProjects)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read with filters testing
+			{
+				Config: examples.ProjectsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_projects.staging", "projects.#", "0"),
+				),
+			},
+			{
+				Config: testAccProjectsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_projects.staging", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.supabase_projects.staging", "projects.0.id", "kwyqzbpyvdkhjwwkdakg"),
+					resource.TestCheckResourceAttr("data.supabase_projects.staging", "projects.0.status", "INACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProjectsDataSourceConfig = `
+data "supabase_projects" "staging" {
+  organization_id = "continued-brown-smelt"
+  name_regex      = "^staging-"
+}
+`
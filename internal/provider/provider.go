@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -18,6 +19,7 @@ import (
 
 // Ensure SupabaseProvider satisfies various provider interfaces.
 var _ provider.Provider = &SupabaseProvider{}
+var _ provider.ProviderWithActions = &SupabaseProvider{}
 
 // SupabaseProvider defines the provider implementation.
 type SupabaseProvider struct {
@@ -82,6 +84,7 @@ func (p *SupabaseProvider) Configure(ctx context.Context, req provider.Configure
 	)
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
 }
 
 func (p *SupabaseProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -89,8 +92,13 @@ func (p *SupabaseProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewProjectResource,
 		NewSettingsResource,
 		NewBranchResource,
+		NewBranchingResource,
 		NewApiKeyResource,
 		NewFunctionResource,
+		NewLegacyApiKeysResource,
+		NewJwtSigningKeyResource,
+		NewProjectAddonResource,
+		NewReadReplicaResource,
 	}
 }
 
@@ -100,6 +108,22 @@ func (p *SupabaseProvider) DataSources(ctx context.Context) []func() datasource.
 		NewPoolerDataSource,
 		NewAPIKeysDataSource,
 		NewFunctionBodyDataSource,
+		NewJwksDataSource,
+		NewConnectionStringDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
+		NewProjectAddonVariantsDataSource,
+		NewRegionsDataSource,
+		NewInstanceSizesDataSource,
+	}
+}
+
+func (p *SupabaseProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewBranchMergeAction,
+		NewBranchPushAction,
+		NewBranchResetAction,
+		NewProjectRestartAction,
 	}
 }
 
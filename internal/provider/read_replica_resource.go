@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// readReplicaTimeout bounds how long replica setup and removal are awaited.
+var readReplicaTimeout = 30 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReadReplicaResource{}
+var _ resource.ResourceWithImportState = &ReadReplicaResource{}
+
+func NewReadReplicaResource() resource.Resource {
+	return &ReadReplicaResource{}
+}
+
+// ReadReplicaResource defines the resource implementation.
+type ReadReplicaResource struct {
+	client *api.ClientWithResponses
+}
+
+// ReadReplicaResourceModel describes the resource data model.
+type ReadReplicaResourceModel struct {
+	ProjectRef       types.String `tfsdk:"project_ref"`
+	Region           types.String `tfsdk:"region"`
+	Host             types.String `tfsdk:"host"`
+	Port             types.Int64  `tfsdk:"port"`
+	User             types.String `tfsdk:"user"`
+	DbName           types.String `tfsdk:"dbname"`
+	ConnectionString types.String `tfsdk:"connection_string"`
+	Id               types.String `tfsdk:"id"`
+}
+
+// nullifyUnknown replaces computed values that could not be read with null.
+func (m *ReadReplicaResourceModel) nullifyUnknown() {
+	if m.Host.IsUnknown() {
+		m.Host = types.StringNull()
+	}
+	if m.Port.IsUnknown() {
+		m.Port = types.Int64Null()
+	}
+	if m.User.IsUnknown() {
+		m.User = types.StringNull()
+	}
+	if m.DbName.IsUnknown() {
+		m.DbName = types.StringNull()
+	}
+	if m.ConnectionString.IsUnknown() {
+		m.ConnectionString = types.StringNull()
+	}
+}
+
+func (r *ReadReplicaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_read_replica"
+}
+
+func (r *ReadReplicaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read replica resource. Replicates the project database to another region.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region of the read replica, such as `us-west-1`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Pooler host of the read replica",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Pooler port of the read replica",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Database user",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dbname": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_string": schema.StringAttribute{
+				MarkdownDescription: "Pooler connection string with a password placeholder, preferring transaction mode",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Read replica identifier, matching `identifier` in the `supabase_pooler` data source",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReadReplicaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ReadReplicaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReadReplicaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(createReadReplica(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		// Save the registered replica so that Terraform taints it instead of leaving it orphaned
+		if !data.Id.IsUnknown() {
+			data.nullifyUnknown()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReadReplicaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReadReplicaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readReadReplica(ctx, &data, r.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReadReplicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement
+	resp.Diagnostics.AddError("Unsupported Operation", "Read replicas cannot be updated in place.")
+}
+
+func (r *ReadReplicaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReadReplicaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(removeReadReplica(ctx, &data, r.client)...)
+}
+
+func (r *ReadReplicaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			`Expected import identifier in the format "project_ref/replica_identifier".`,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_ref"), types.StringValue(parts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), types.StringValue(readReplicaRegion(parts[0], parts[1])))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(parts[1]))...)
+}
+
+// readReplicaRegion extracts the region from replica identifiers, which are
+// formatted as <project_ref>-rr-<region>-<suffix>.
+func readReplicaRegion(projectRef, identifier string) string {
+	region := strings.TrimPrefix(identifier, projectRef+"-rr-")
+	if i := strings.LastIndex(region, "-"); i > 0 {
+		region = region[:i]
+	}
+	return region
+}
+
+// listReadReplicaPoolers returns the pooler configs of all read replicas of a
+// project, which is the only place the Management API lists replicas.
+func listReadReplicaPoolers(ctx context.Context, projectRef string, client *api.ClientWithResponses) ([]api.SupavisorConfigResponse, diag.Diagnostics) {
+	httpResp, err := client.V1GetPoolerConfigWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read read replicas, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read read replicas, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	var replicas []api.SupavisorConfigResponse
+	for _, pooler := range *httpResp.JSON200 {
+		if pooler.DatabaseType == api.SupavisorConfigResponseDatabaseTypeREADREPLICA {
+			replicas = append(replicas, pooler)
+		}
+	}
+	return replicas, nil
+}
+
+func readReadReplica(ctx context.Context, state *ReadReplicaResourceModel, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+	replicas, diags := listReadReplicaPoolers(ctx, state.ProjectRef.ValueString(), client)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	found := false
+	for _, pooler := range replicas {
+		if pooler.Identifier != state.Id.ValueString() {
+			continue
+		}
+		if found && pooler.PoolMode != api.SupavisorConfigResponsePoolModeTransaction {
+			continue
+		}
+		found = true
+		state.Host = types.StringValue(pooler.DbHost)
+		state.Port = types.Int64Value(int64(pooler.DbPort))
+		state.User = types.StringValue(pooler.DbUser)
+		state.DbName = types.StringValue(pooler.DbName)
+		state.ConnectionString = types.StringValue(pooler.ConnectionString)
+	}
+	return found, nil
+}
+
+func createReadReplica(ctx context.Context, plan *ReadReplicaResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	projectRef := plan.ProjectRef.ValueString()
+	// Remember existing replicas to tell the new one apart
+	replicas, diags := listReadReplicaPoolers(ctx, projectRef, client)
+	if diags.HasError() {
+		return diags
+	}
+	var existing []string
+	for _, pooler := range replicas {
+		existing = append(existing, pooler.Identifier)
+	}
+
+	httpResp, err := client.V1SetupAReadReplicaWithResponse(ctx, projectRef, api.V1SetupAReadReplicaJSONRequestBody{
+		ReadReplicaRegion: api.SetUpReadReplicaBodyReadReplicaRegion(plan.Region.ValueString()),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to set up read replica, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() != http.StatusCreated && httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to set up read replica, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	diags = waitFor(ctx, readReplicaTimeout, "read replica to be registered", func(ctx context.Context) (bool, diag.Diagnostics) {
+		replicas, diags := listReadReplicaPoolers(ctx, projectRef, client)
+		if diags.HasError() {
+			return false, diags
+		}
+		for _, pooler := range replicas {
+			if !slices.Contains(existing, pooler.Identifier) && readReplicaRegion(projectRef, pooler.Identifier) == plan.Region.ValueString() {
+				plan.Id = types.StringValue(pooler.Identifier)
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := waitForServicesHealthy(ctx, plan.Id.ValueString(), []api.V1GetServicesHealthParamsServices{api.Db}, readReplicaTimeout, client); diags.HasError() {
+		return diags
+	}
+	if _, diags := readReadReplica(ctx, plan, client); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func removeReadReplica(ctx context.Context, state *ReadReplicaResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+	projectRef := state.ProjectRef.ValueString()
+	httpResp, err := client.V1RemoveAReadReplicaWithResponse(ctx, projectRef, api.V1RemoveAReadReplicaJSONRequestBody{
+		DatabaseIdentifier: state.Id.ValueString(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to remove read replica, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	// Deleted project is an orphan resource, not returning error so it can be destroyed.
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("read replica not found: %s", state.Id.ValueString()))
+		return nil
+	}
+	if httpResp.StatusCode() != http.StatusCreated && httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to remove read replica, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return waitFor(ctx, readReplicaTimeout, "read replica to be removed", func(ctx context.Context) (bool, diag.Diagnostics) {
+		found, diags := readReadReplica(ctx, state, client)
+		return !found, diags
+	})
+}
+
+// waitForServicesHealthy polls the health endpoint until all requested
+// services of a project or read replica report healthy.
+func waitForServicesHealthy(ctx context.Context, ref string, services []api.V1GetServicesHealthParamsServices, timeout time.Duration, client *api.ClientWithResponses) diag.Diagnostics {
+	return waitFor(ctx, timeout, fmt.Sprintf("%s to be healthy", ref), func(ctx context.Context) (bool, diag.Diagnostics) {
+		return checkServicesHealthy(ctx, ref, services, client)
+	})
+}
+
+// checkServicesHealthy reports whether all requested services are healthy.
+func checkServicesHealthy(ctx context.Context, ref string, services []api.V1GetServicesHealthParamsServices, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+	httpResp, err := client.V1GetServicesHealthWithResponse(ctx, ref, &api.V1GetServicesHealthParams{Services: services})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read service health, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	// Newly provisioned databases are not reachable right away
+	if httpResp.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read service health, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	for _, service := range *httpResp.JSON200 {
+		tflog.Trace(ctx, fmt.Sprintf("%s service status: %s", service.Name, service.Status))
+		if !service.Healthy {
+			return false, nil
+		}
+	}
+	return len(*httpResp.JSON200) > 0, nil
+}
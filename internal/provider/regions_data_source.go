@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RegionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &RegionsDataSource{}
+}
+
+// RegionsDataSource defines the data source implementation.
+type RegionsDataSource struct {
+	client *api.ClientWithResponses
+}
+
+// RegionsDataSourceModel describes the data source data model.
+type RegionsDataSourceModel struct {
+	OrganizationSlug      types.String      `tfsdk:"organization_slug"`
+	Continent             types.String      `tfsdk:"continent"`
+	InstanceSize          types.String      `tfsdk:"instance_size"`
+	Regions               []RegionModel     `tfsdk:"regions"`
+	SmartGroups           []SmartGroupModel `tfsdk:"smart_groups"`
+	RecommendedRegion     types.String      `tfsdk:"recommended_region"`
+	RecommendedSmartGroup types.String      `tfsdk:"recommended_smart_group"`
+}
+
+// RegionModel describes a single region a project can be created in.
+type RegionModel struct {
+	Code      types.String `tfsdk:"code"`
+	Name      types.String `tfsdk:"name"`
+	Provider  types.String `tfsdk:"provider"`
+	Status    types.String `tfsdk:"status"`
+	Available types.Bool   `tfsdk:"available"`
+}
+
+// SmartGroupModel describes a group of regions a project can be created in.
+type SmartGroupModel struct {
+	Code types.String `tfsdk:"code"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *RegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *RegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Regions data source",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_slug": schema.StringAttribute{
+				MarkdownDescription: "Organization slug",
+				Required:            true,
+			},
+			"continent": schema.StringAttribute{
+				MarkdownDescription: "Continent code used for recommendations, one of `NA`, `SA`, `EU`, `AF`, `AS`, `OC` or `AN`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.NA),
+						string(api.SA),
+						string(api.EU),
+						string(api.AF),
+						string(api.AS),
+						string(api.OC),
+						string(api.AN),
+					),
+				},
+			},
+			"instance_size": schema.StringAttribute{
+				MarkdownDescription: "Report availability of regions for this instance size. See the `supabase_instance_sizes` data source for available sizes.",
+				Optional:            true,
+			},
+			"regions": schema.ListNestedAttribute{
+				MarkdownDescription: "Regions a project can be created in",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Region code, such as `us-east-1`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Region display name",
+							Computed:            true,
+						},
+						"provider": schema.StringAttribute{
+							MarkdownDescription: "Cloud provider of the region",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Reason the region is unavailable, either `capacity` or `other`",
+							Computed:            true,
+						},
+						"available": schema.BoolAttribute{
+							MarkdownDescription: "Whether new projects can currently be created in the region",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"smart_groups": schema.ListNestedAttribute{
+				MarkdownDescription: "Region groups a project can be created in",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Region group code, such as `americas`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Region group display name",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"recommended_region": schema.StringAttribute{
+				MarkdownDescription: "Code of the recommended region",
+				Computed:            true,
+			},
+			"recommended_smart_group": schema.StringAttribute{
+				MarkdownDescription: "Code of the recommended region group",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := api.V1GetAvailableRegionsParams{OrganizationSlug: data.OrganizationSlug.ValueString()}
+	if !data.Continent.IsNull() {
+		params.Continent = Ptr(api.V1GetAvailableRegionsParamsContinent(data.Continent.ValueString()))
+	}
+	if !data.InstanceSize.IsNull() {
+		params.DesiredInstanceSize = Ptr(api.V1GetAvailableRegionsParamsDesiredInstanceSize(data.InstanceSize.ValueString()))
+	}
+	regions, diags := listAvailableRegions(ctx, params, d.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Regions = make([]RegionModel, 0, len(regions.All.Specific))
+	for _, region := range regions.All.Specific {
+		status := types.StringNull()
+		if region.Status != nil {
+			status = types.StringValue(string(*region.Status))
+		}
+		data.Regions = append(data.Regions, RegionModel{
+			Code:      types.StringValue(region.Code),
+			Name:      types.StringValue(region.Name),
+			Provider:  types.StringValue(string(region.Provider)),
+			Status:    status,
+			Available: types.BoolValue(region.Status == nil),
+		})
+	}
+	data.SmartGroups = make([]SmartGroupModel, 0, len(regions.All.SmartGroup))
+	for _, group := range regions.All.SmartGroup {
+		data.SmartGroups = append(data.SmartGroups, SmartGroupModel{
+			Code: types.StringValue(string(group.Code)),
+			Name: types.StringValue(group.Name),
+		})
+	}
+	data.RecommendedRegion = types.StringNull()
+	if len(regions.Recommendations.Specific) > 0 {
+		data.RecommendedRegion = types.StringValue(regions.Recommendations.Specific[0].Code)
+	}
+	data.RecommendedSmartGroup = types.StringNull()
+	if code := regions.Recommendations.SmartGroup.Code; len(code) > 0 {
+		data.RecommendedSmartGroup = types.StringValue(string(code))
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAvailableRegions fetches the region catalog of an organization, along
+// with the capacity for the desired instance size when one is set.
+func listAvailableRegions(ctx context.Context, params api.V1GetAvailableRegionsParams, client *api.ClientWithResponses) (*api.RegionsInfo, diag.Diagnostics) {
+	httpResp, err := client.V1GetAvailableRegionsWithResponse(ctx, &params)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read available regions, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	// Sizes are validated by the API so that new sizes don't need a provider release
+	if params.DesiredInstanceSize != nil && (httpResp.StatusCode() == http.StatusBadRequest || httpResp.StatusCode() == http.StatusUnprocessableEntity) {
+		msg := fmt.Sprintf("Instance size %q is not supported, got status %d: %s", *params.DesiredInstanceSize, httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Invalid Instance Size", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read available regions, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return httpResp.JSON200, nil
+}
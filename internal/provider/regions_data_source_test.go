@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccRegionsDataSource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/available-regions").
+		MatchParam("organization_slug", "continued-brown-smelt").
+		MatchParam("desired_instance_size", "micro").
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(testAccAvailableRegions())
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: examples.RegionsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "regions.#", "3"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "regions.0.code", "us-east-1"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "regions.0.provider", "AWS"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "regions.0.available", "true"),
+					resource.TestCheckNoResourceAttr("data.supabase_regions.all", "regions.0.status"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "regions.2.status", "capacity"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "regions.2.available", "false"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "smart_groups.#", "3"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "smart_groups.1.code", "emea"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "recommended_region", "us-east-1"),
+					resource.TestCheckResourceAttr("data.supabase_regions.all", "recommended_smart_group", "americas"),
+				),
+			},
+		},
+	})
+}
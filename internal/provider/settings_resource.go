@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -64,7 +65,7 @@ func (r *SettingsResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"database": schema.StringAttribute{
 				CustomType:          jsontypes.NormalizedType{},
-				MarkdownDescription: "Database settings as [serialised JSON](https://api.supabase.com/api/v1#/projects%20config/updateConfig)",
+				MarkdownDescription: "Database settings as [serialised JSON](https://api.supabase.com/api/v1#/projects%20config/updateConfig). Changing settings that require a restart, such as `max_connections` or `shared_buffers`, restarts the database unless `restart_database` is set to `false`.",
 				Optional:            true,
 			},
 			"pooler": schema.StringAttribute{
@@ -149,6 +150,7 @@ func (r *SettingsResource) Create(ctx context.Context, req resource.CreateReques
 		resp.Diagnostics.Append(updateStorageConfig(ctx, &data, r.client)...)
 	}
 	// TODO: update all settings above concurrently
+	resp.Diagnostics = explainPausedProject(ctx, data.ProjectRef.ValueString(), r.client, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -190,6 +192,7 @@ func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, r
 		resp.Diagnostics.Append(readStorageConfig(ctx, &data, r.client)...)
 	}
 	// TODO: read all settings above concurrently
+	resp.Diagnostics = explainPausedProject(ctx, data.Id.ValueString(), r.client, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -233,6 +236,7 @@ func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateReques
 		resp.Diagnostics.Append(updateStorageConfig(ctx, &planData, r.client)...)
 	}
 	// TODO: update all settings above concurrently
+	resp.Diagnostics = explainPausedProject(ctx, planData.ProjectRef.ValueString(), r.client, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -397,11 +401,80 @@ func readDatabaseConfig(ctx context.Context, state *SettingsResourceModel, clien
 	return nil
 }
 
+// databaseRestartGracePeriod bounds how long a restarting database may keep
+// reporting healthy before it goes down.
+var databaseRestartGracePeriod = time.Minute
+
+// postgresRestartKeys are database settings that only take effect after the
+// database is restarted.
+var postgresRestartKeys = []string{
+	"max_connections",
+	"max_locks_per_transaction",
+	"max_replication_slots",
+	"max_wal_senders",
+	"max_worker_processes",
+	"shared_buffers",
+	"track_activity_query_size",
+	"track_commit_timestamp",
+}
+
+// databaseRestartRequired reports whether any setting that needs a restart
+// differs between the desired and live database settings. Live settings are
+// only fetched when the desired settings include a restart-only key.
+func databaseRestartRequired(ctx context.Context, projectRef string, desired jsontypes.Normalized, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+	var want map[string]any
+	if diags := desired.Unmarshal(&want); diags.HasError() {
+		return false, nil
+	}
+	keys := []string{}
+	for _, key := range postgresRestartKeys {
+		if _, ok := want[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return false, nil
+	}
+
+	httpResp, err := client.V1GetPostgresConfigWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read database settings, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read database settings, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	// Round trip through json so that both sides compare with the same types
+	var have map[string]any
+	if err := json.Unmarshal(httpResp.Body, &have); err != nil {
+		msg := fmt.Sprintf("Unable to read database settings, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	for _, key := range keys {
+		if !reflect.DeepEqual(want[key], have[key]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func updateDatabaseConfig(ctx context.Context, plan *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
 	var body api.UpdatePostgresConfigBody
 	if diags := plan.Database.Unmarshal(&body); diags.HasError() {
 		return diags
 	}
+	// An explicit restart_database setting always takes precedence
+	restart := false
+	if body.RestartDatabase == nil {
+		var diags diag.Diagnostics
+		if restart, diags = databaseRestartRequired(ctx, plan.ProjectRef.ValueString(), plan.Database, client); diags.HasError() {
+			return diags
+		}
+	}
+	if restart {
+		body.RestartDatabase = Ptr(true)
+	}
 
 	httpResp, err := client.V1UpdatePostgresConfigWithResponse(ctx, plan.ProjectRef.ValueString(), body)
 	if err != nil {
@@ -417,9 +490,48 @@ func updateDatabaseConfig(ctx context.Context, plan *SettingsResourceModel, clie
 		msg := fmt.Sprintf("Unable to update database settings, got error: %s", err)
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
+	if restart {
+		tflog.Trace(ctx, "wait for database restart")
+		return waitForDatabaseRestart(ctx, plan.ProjectRef.ValueString(), client)
+	}
 	return nil
 }
 
+// restartDatabase restarts the project database without changing any of its
+// settings, since the Management API has no dedicated restart endpoint.
+func restartDatabase(ctx context.Context, projectRef string, client *api.ClientWithResponses) diag.Diagnostics {
+	body := api.UpdatePostgresConfigBody{RestartDatabase: Ptr(true)}
+	httpResp, err := client.V1UpdatePostgresConfigWithResponse(ctx, projectRef, body)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to restart database, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to restart database, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return waitForDatabaseRestart(ctx, projectRef, client)
+}
+
+// waitForDatabaseRestart waits for the database to go down and come back up.
+// Restarts are scheduled asynchronously, so the database may still report
+// healthy right after the request. If it never goes down within the grace
+// period, the restart is assumed to have completed already.
+func waitForDatabaseRestart(ctx context.Context, projectRef string, client *api.ClientWithResponses) diag.Diagnostics {
+	services := []api.V1GetServicesHealthParamsServices{api.Db}
+	var failed diag.Diagnostics
+	waitFor(ctx, databaseRestartGracePeriod, "database to restart", func(ctx context.Context) (bool, diag.Diagnostics) {
+		healthy, diags := checkServicesHealthy(ctx, projectRef, services, client)
+		failed = diags
+		return !healthy, diags
+	})
+	if failed.HasError() {
+		return failed
+	}
+	return waitForServicesHealthy(ctx, projectRef, services, projectStatusTimeout, client)
+}
+
 func parseConfig(field jsontypes.Normalized, config any) (jsontypes.Normalized, error) {
 	partial := make(map[string]any)
 	if diags := field.Unmarshal(&partial); !diags.HasError() {
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -549,6 +550,45 @@ resource "supabase_settings" "test" {
 	})
 }
 
+func TestAccSettingsResource_PausedProject(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New("https://api.supabase.com").
+		Patch("/v1/projects/mayuaycdtijbctgqbycg/config/auth").
+		Reply(http.StatusBadRequest).
+		JSON(map[string]any{"message": "Failed to update auth config"})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg$").
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectResponse{
+			Id:             "mayuaycdtijbctgqbycg",
+			Name:           "foo",
+			OrganizationId: "continued-brown-smelt",
+			Region:         "us-east-1",
+			Status:         api.V1ProjectResponseStatusINACTIVE,
+			CreatedAt:      "2025-01-01T00:00:00Z",
+		})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "supabase_settings" "test" {
+  project_ref = "mayuaycdtijbctgqbycg"
+
+  auth = jsonencode({
+    site_url = "http://localhost:3000"
+  })
+}
+`,
+				ExpectError: regexp.MustCompile(`Project mayuaycdtijbctgqbycg is INACTIVE`),
+			},
+		},
+	})
+}
+
 func TestAccSettingsResource_IgnoreChanges(t *testing.T) {
 	defer gock.OffAll()
 
@@ -855,3 +895,90 @@ resource "supabase_settings" "production" {
   # })
 }
 `
+
+func TestAccSettingsResource_RestartDatabase(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	// Live settings differ from the desired ones, so create restarts the database
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/config/database/postgres").
+		Reply(http.StatusOK).
+		JSON(api.PostgresConfigResponse{
+			MaxConnections: Ptr(100),
+		})
+	gock.New("https://api.supabase.com").
+		Put("/v1/projects/mayuaycdtijbctgqbycg/config/database/postgres").
+		AddMatcher(testAccBodyContains(`"max_connections":200`, `"restart_database":true`)).
+		Reply(http.StatusOK).
+		JSON(api.PostgresConfigResponse{
+			MaxConnections: Ptr(200),
+		})
+	gock.New("https://api.supabase.com").
+		Put("/v1/projects/mayuaycdtijbctgqbycg/config/database/postgres").
+		AddMatcher(testAccBodyContains(`"statement_timeout":"10s"`)).
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			matched, err := testAccBodyContains(`"restart_database"`)(req, nil)
+			return !matched, err
+		}).
+		Reply(http.StatusOK).
+		JSON(api.PostgresConfigResponse{
+			MaxConnections:   Ptr(200),
+			StatementTimeout: Ptr("10s"),
+		})
+	down := gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/health").
+		MatchParam("services", "db").
+		Reply(http.StatusOK).
+		JSON([]api.V1ServiceHealthResponse{{
+			Name:    api.V1ServiceHealthResponseNameDb,
+			Healthy: false,
+			Status:  api.COMINGUP,
+		}})
+	up := gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/health").
+		MatchParam("services", "db").
+		Reply(http.StatusOK).
+		JSON([]api.V1ServiceHealthResponse{{
+			Name:    api.V1ServiceHealthResponseNameDb,
+			Healthy: true,
+			Status:  api.ACTIVEHEALTHY,
+		}})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/config/database/postgres").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.PostgresConfigResponse{
+			MaxConnections:   Ptr(200),
+			StatementTimeout: Ptr("10s"),
+		})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create restarts the database for max_connections
+			{
+				Config: testAccSettingsResourceDatabaseConfig(`{ max_connections = 200 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_settings.production", "database", `{"max_connections":200}`),
+					// The database goes down before it reports healthy again
+					testAccMocksDone(down, up),
+				),
+			},
+			// Restart-only settings that match the live database are not restarted
+			{
+				Config: testAccSettingsResourceDatabaseConfig(`{ max_connections = 200, statement_timeout = "10s" }`),
+				Check:  resource.TestCheckResourceAttr("supabase_settings.production", "database", `{"max_connections":200,"statement_timeout":"10s"}`),
+			},
+		},
+	})
+}
+
+func testAccSettingsResourceDatabaseConfig(database string) string {
+	return fmt.Sprintf(`
+resource "supabase_settings" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  database    = jsonencode(%s)
+}
+`, database)
+}
@@ -1,6 +1,14 @@
 package provider
 
 import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/oapi-codegen/nullable"
 )
@@ -18,3 +26,77 @@ func NullableToString[T ~string](n nullable.Nullable[T]) tftypes.String {
 
 	return tftypes.StringNull()
 }
+
+// NullableToInt64 converts an oapi-codegen [nullable.Nullable] integer to an
+// appropriate terraform int64 type.
+func NullableToInt64[T ~int | ~int32 | ~int64](n nullable.Nullable[T]) tftypes.Int64 {
+	if n.IsSpecified() && !n.IsNull() {
+		return tftypes.Int64Value(int64(n.MustGet()))
+	}
+
+	return tftypes.Int64Null()
+}
+
+// NullableToTimestamp converts an oapi-codegen [nullable.Nullable] time to an
+// RFC 3339 formatted terraform string type.
+func NullableToTimestamp(n nullable.Nullable[time.Time]) tftypes.String {
+	if n.IsSpecified() && !n.IsNull() {
+		return tftypes.StringValue(n.MustGet().Format(time.RFC3339))
+	}
+
+	return tftypes.StringNull()
+}
+
+const passwordAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// generatePassword returns a random alphanumeric password of the given length.
+// Special characters are avoided so that the password is safe to embed in
+// connection strings without escaping.
+func generatePassword(length int) (string, error) {
+	size := big.NewInt(int64(len(passwordAlphabet)))
+	password := make([]byte, length)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+	return string(password), nil
+}
+
+// checkDeletionProtection returns an error diagnostic when the resource is
+// protected from being destroyed, either directly or by a replacement.
+func checkDeletionProtection(protected tftypes.Bool, resourceType, id string) diag.Diagnostics {
+	if !protected.ValueBool() {
+		return nil
+	}
+	msg := fmt.Sprintf("%s %s has deletion protection enabled. Set `deletion_protection = false` and apply that change before destroying or replacing it.", resourceType, id)
+	return diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("deletion_protection"), "Deletion Protection Enabled", msg)}
+}
+
+// pollInterval is the delay between consecutive status checks of long running operations.
+var pollInterval = 5 * time.Second
+
+// waitFor calls check until it reports done, returns an error or the timeout elapses.
+// The first check happens immediately so that operations that complete synchronously
+// don't incur any delay.
+func waitFor(ctx context.Context, timeout time.Duration, operation string, check func(ctx context.Context) (bool, diag.Diagnostics)) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		done, diags := check(ctx)
+		if done || diags.HasError() {
+			return diags
+		}
+		select {
+		case <-ctx.Done():
+			msg := fmt.Sprintf("Timed out after %s waiting for %s", timeout, operation)
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Timeout Error", msg)}
+		case <-ticker.C:
+		}
+	}
+}